@@ -0,0 +1,124 @@
+package optionanalytics
+
+import (
+	"fmt"
+	"math"
+)
+
+// DefaultBinomialSteps 是 steps 不大于 0 时使用的二叉树步数。
+const DefaultBinomialSteps = 200
+
+// binomialTree 计算 CRR 美式二叉树，返回根节点价格以及第 1、2 步各节点的价格，用于推导 Delta、Gamma、Theta。
+// 波动率过低时（σ√dt 小于 |r−q|·dt）风险中性概率落在 [0,1] 之外，树不再无套利，此时返回 ErrInvalidInput。
+func binomialTree(in Inputs, steps int) (root float64, step1 [2]float64, step2 [3]float64, err error) {
+	dt := in.Years / float64(steps)
+	up := math.Exp(in.Volatility * math.Sqrt(dt))
+	down := 1 / up
+	prob := (math.Exp((in.Rate-in.DividendYield)*dt) - down) / (up - down)
+	if !(prob >= 0 && prob <= 1) {
+		return 0, step1, step2, fmt.Errorf("%w: volatility %g too low for %d binomial steps (up probability %g), increase steps", ErrInvalidInput, in.Volatility, steps, prob)
+	}
+	disc := math.Exp(-in.Rate * dt)
+
+	exercise := func(spot float64) float64 {
+		if in.Call {
+			return math.Max(spot-in.Strike, 0)
+		}
+		return math.Max(in.Strike-spot, 0)
+	}
+
+	values := make([]float64, steps+1)
+	for i := 0; i <= steps; i++ {
+		values[i] = exercise(in.Spot * math.Pow(up, float64(steps-i)) * math.Pow(down, float64(i)))
+	}
+	for n := steps - 1; n >= 0; n-- {
+		for i := 0; i <= n; i++ {
+			spot := in.Spot * math.Pow(up, float64(n-i)) * math.Pow(down, float64(i))
+			values[i] = math.Max(disc*(prob*values[i]+(1-prob)*values[i+1]), exercise(spot))
+		}
+		switch n {
+		case 2:
+			copy(step2[:], values[:3])
+		case 1:
+			copy(step1[:], values[:2])
+		}
+	}
+	return values[0], step1, step2, nil
+}
+
+func binomialSteps(steps int) int {
+	if steps <= 0 {
+		return DefaultBinomialSteps
+	}
+	if steps < 3 {
+		return 3
+	}
+	return steps
+}
+
+// BinomialPrice 返回美式期权的 CRR 二叉树价格。
+func BinomialPrice(in Inputs, steps int) (float64, error) {
+	if err := in.validate(); err != nil {
+		return 0, err
+	}
+	if in.Years == 0 {
+		return in.intrinsic(), nil
+	}
+	root, _, _, err := binomialTree(in, binomialSteps(steps))
+	return root, err
+}
+
+// BinomialGreeks 返回美式期权的 Greeks：Delta、Gamma、Theta 取自树节点，Vega、Rho 用中心差分重新定价。
+func BinomialGreeks(in Inputs, steps int) (Greeks, error) {
+	if err := in.validate(); err != nil {
+		return Greeks{}, err
+	}
+	if in.Years == 0 {
+		return in.expiredGreeks(), nil
+	}
+	steps = binomialSteps(steps)
+	root, step1, step2, err := binomialTree(in, steps)
+	if err != nil {
+		return Greeks{}, err
+	}
+	dt := in.Years / float64(steps)
+	up := math.Exp(in.Volatility * math.Sqrt(dt))
+	down := 1 / up
+
+	spotUp, spotDown := in.Spot*up, in.Spot*down
+	spotUU, spotDD := in.Spot*up*up, in.Spot*down*down
+	deltaUp := (step2[0] - step2[1]) / (spotUU - in.Spot)
+	deltaDown := (step2[1] - step2[2]) / (in.Spot - spotDD)
+
+	g := Greeks{
+		Delta: (step1[0] - step1[1]) / (spotUp - spotDown),
+		Gamma: (deltaUp - deltaDown) / (0.5 * (spotUU - spotDD)),
+		Theta: (step2[1] - root) / (2 * dt) / daysPerYear,
+	}
+
+	const volBump, rateBump = 0.001, 0.0001
+	// 差分所需的重新定价逐个检查，任一树不可用即返回错误。
+	var repriceErr error
+	reprice := func(b Inputs) float64 {
+		price, _, _, err := binomialTree(b, steps)
+		if err != nil && repriceErr == nil {
+			repriceErr = err
+		}
+		return price
+	}
+	volLow := math.Max(in.Volatility-volBump, in.Volatility/2)
+	g.Vega = (reprice(in.WithVolatility(in.Volatility+volBump)) - reprice(in.WithVolatility(volLow))) / (in.Volatility + volBump - volLow) / 100
+
+	rateUp, rateDown := in, in
+	rateUp.Rate += rateBump
+	rateDown.Rate -= rateBump
+	g.Rho = (reprice(rateUp) - reprice(rateDown)) / (2 * rateBump) / 100
+	if repriceErr != nil {
+		return Greeks{}, repriceErr
+	}
+
+	if math.IsNaN(g.Delta) || math.IsNaN(g.Gamma) || math.IsNaN(g.Theta) {
+		return Greeks{}, fmt.Errorf("%w: degenerate binomial tree", ErrInvalidInput)
+	}
+	return g, nil
+}
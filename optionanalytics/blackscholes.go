@@ -0,0 +1,65 @@
+package optionanalytics
+
+import "math"
+
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normPDF(x float64) float64 {
+	return math.Exp(-0.5*x*x) / math.Sqrt(2*math.Pi)
+}
+
+func (in Inputs) d1d2() (float64, float64) {
+	sqrtT := math.Sqrt(in.Years)
+	d1 := (math.Log(in.Spot/in.Strike) + (in.Rate-in.DividendYield+0.5*in.Volatility*in.Volatility)*in.Years) / (in.Volatility * sqrtT)
+	return d1, d1 - in.Volatility*sqrtT
+}
+
+// BlackScholesPrice 返回欧式期权的 Black-Scholes-Merton 理论价。
+func BlackScholesPrice(in Inputs) (float64, error) {
+	if err := in.validate(); err != nil {
+		return 0, err
+	}
+	if in.Years == 0 {
+		return in.intrinsic(), nil
+	}
+	d1, d2 := in.d1d2()
+	spot := in.Spot * math.Exp(-in.DividendYield*in.Years)
+	strike := in.Strike * math.Exp(-in.Rate*in.Years)
+	if in.Call {
+		return spot*normCDF(d1) - strike*normCDF(d2), nil
+	}
+	return strike*normCDF(-d2) - spot*normCDF(-d1), nil
+}
+
+// BlackScholesGreeks 返回欧式期权的解析 Greeks。
+func BlackScholesGreeks(in Inputs) (Greeks, error) {
+	if err := in.validate(); err != nil {
+		return Greeks{}, err
+	}
+	if in.Years == 0 {
+		return in.expiredGreeks(), nil
+	}
+	d1, d2 := in.d1d2()
+	sqrtT := math.Sqrt(in.Years)
+	divDisc := math.Exp(-in.DividendYield * in.Years)
+	rateDisc := math.Exp(-in.Rate * in.Years)
+	pdf := normPDF(d1)
+
+	g := Greeks{
+		Gamma: divDisc * pdf / (in.Spot * in.Volatility * sqrtT),
+		Vega:  in.Spot * divDisc * pdf * sqrtT / 100,
+	}
+	decay := -in.Spot * divDisc * pdf * in.Volatility / (2 * sqrtT)
+	if in.Call {
+		g.Delta = divDisc * normCDF(d1)
+		g.Theta = (decay - in.Rate*in.Strike*rateDisc*normCDF(d2) + in.DividendYield*in.Spot*divDisc*normCDF(d1)) / daysPerYear
+		g.Rho = in.Strike * in.Years * rateDisc * normCDF(d2) / 100
+	} else {
+		g.Delta = divDisc * (normCDF(d1) - 1)
+		g.Theta = (decay + in.Rate*in.Strike*rateDisc*normCDF(-d2) - in.DividendYield*in.Spot*divDisc*normCDF(-d1)) / daysPerYear
+		g.Rho = -in.Strike * in.Years * rateDisc * normCDF(-d2) / 100
+	}
+	return g, nil
+}
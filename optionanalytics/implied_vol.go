@@ -0,0 +1,104 @@
+package optionanalytics
+
+import (
+	"fmt"
+	"math"
+)
+
+// Pricer 是按 Inputs 计算期权价格的模型。
+type Pricer func(Inputs) (float64, error)
+
+// BlackScholes 是欧式期权定价模型。
+var BlackScholes Pricer = BlackScholesPrice
+
+// Binomial 返回指定步数的美式二叉树定价模型。
+func Binomial(steps int) Pricer {
+	return func(in Inputs) (float64, error) {
+		return BinomialPrice(in, steps)
+	}
+}
+
+const (
+	minVolatility = 1e-4
+	maxVolatility = 10.0
+	volTolerance  = 1e-8
+	maxIterations = 200
+)
+
+// ImpliedVolatility 用 pricer 反解使理论价等于 price 的波动率，in.Volatility 会被忽略。
+// price 不高于时间价值为零的无套利下限（此时任意足够小的波动率都满足，解不唯一）或高于上限时返回 ErrInvalidInput；
+// 下限不依赖 pricer 计算，pricer 在极低波动率下无法定价（如二叉树步数不足）时从更高的波动率开始搜索。
+func ImpliedVolatility(price float64, in Inputs, pricer Pricer) (float64, error) {
+	if pricer == nil {
+		pricer = BlackScholes
+	}
+	if !(in.Years > 0) {
+		return 0, fmt.Errorf("%w: implied volatility requires time to expiry", ErrInvalidInput)
+	}
+	if bound := in.lowerBound(); price <= bound+volTolerance {
+		return 0, fmt.Errorf("%w: price %g at or below zero time value bound %g", ErrInvalidInput, price, bound)
+	}
+
+	low, high := minVolatility, 2.0
+	lowPrice, err := pricer(in.WithVolatility(low))
+	for err != nil && low < high {
+		low *= 2
+		lowPrice, err = pricer(in.WithVolatility(low))
+	}
+	if err != nil {
+		return 0, err
+	}
+	if price <= lowPrice+volTolerance {
+		return 0, fmt.Errorf("%w: price %g at or below model price %g at volatility %g", ErrInvalidInput, price, lowPrice, low)
+	}
+	highPrice, err := pricer(in.WithVolatility(high))
+	if err != nil {
+		return 0, err
+	}
+	for highPrice < price {
+		if high >= maxVolatility {
+			return 0, fmt.Errorf("%w: price %g above upper bound %g", ErrInvalidInput, price, highPrice)
+		}
+		low, lowPrice = high, highPrice
+		high = math.Min(high*2, maxVolatility)
+		if highPrice, err = pricer(in.WithVolatility(high)); err != nil {
+			return 0, err
+		}
+	}
+
+	// 带区间保护的割线法：割线点落在区间外时退回二分。
+	for i := 0; i < maxIterations; i++ {
+		vol := 0.5 * (low + high)
+		if highPrice > lowPrice {
+			if guess := low + (price-lowPrice)*(high-low)/(highPrice-lowPrice); guess > low && guess < high {
+				vol = guess
+			}
+		}
+		current, err := pricer(in.WithVolatility(vol))
+		if err != nil {
+			return 0, err
+		}
+		if math.Abs(current-price) < volTolerance || high-low < volTolerance {
+			return vol, nil
+		}
+		if current < price {
+			low, lowPrice = vol, current
+		} else {
+			high, highPrice = vol, current
+		}
+		// 割线只从一侧逼近时收缩另一侧，避免停滞。
+		if i%2 == 1 {
+			mid := 0.5 * (low + high)
+			midPrice, err := pricer(in.WithVolatility(mid))
+			if err != nil {
+				return 0, err
+			}
+			if midPrice < price {
+				low, lowPrice = mid, midPrice
+			} else {
+				high, highPrice = mid, midPrice
+			}
+		}
+	}
+	return 0, ErrNoConvergence
+}
@@ -0,0 +1,154 @@
+// Package optionanalytics 提供纯 Go 的期权定价与风险指标计算：Black-Scholes（欧式）、
+// CRR 二叉树（美式）、Greeks 与隐含波动率。网关未返回 Greeks 或需要做情景分析时使用。
+//
+// Theta 以每自然日计，Vega 与 Rho 以波动率/利率变动 1 个百分点计，与行情接口返回的口径一致。
+package optionanalytics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	tigeropen "tigeropen/src"
+)
+
+var (
+	ErrInvalidInput  = errors.New("invalid option input")
+	ErrNoConvergence = errors.New("implied volatility did not converge")
+)
+
+// daysPerYear 是年化使用的自然日天数。
+const daysPerYear = 365.0
+
+// Inputs 是定价模型的输入，Rate 与 DividendYield 为连续复利年化值，Years 为距到期的年数。
+type Inputs struct {
+	Spot          float64
+	Strike        float64
+	Rate          float64
+	DividendYield float64
+	Volatility    float64
+	Years         float64
+	Call          bool
+}
+
+// Greeks 是期权的风险指标。
+type Greeks struct {
+	Delta float64
+	Gamma float64
+	Theta float64 // 每自然日。
+	Vega  float64 // 波动率变动 1 个百分点。
+	Rho   float64 // 利率变动 1 个百分点。
+}
+
+// NewInputs 由期权 Contract 构造模型输入，到期时间取到期日当地 16:00 收盘，
+// 时区按 Currency 推断（HKD 为港股，CNH/CNY 为 A 股，SGD 为新加坡，其余为美股）。
+// Volatility 需由调用方另行设置，或通过 ImpliedVolatility 求解。
+func NewInputs(c tigeropen.Contract, spot, rate, dividendYield float64, valuation time.Time) (Inputs, error) {
+	if c.Strike == nil {
+		return Inputs{}, fmt.Errorf("%w: contract has no strike", ErrInvalidInput)
+	}
+	call, err := parsePutCall(c.PutCall)
+	if err != nil {
+		return Inputs{}, err
+	}
+	expiry, err := ExpiryTime(c)
+	if err != nil {
+		return Inputs{}, err
+	}
+	years := expiry.Sub(valuation).Hours() / 24 / daysPerYear
+	if years < 0 {
+		years = 0
+	}
+	return Inputs{
+		Spot:          spot,
+		Strike:        *c.Strike,
+		Rate:          rate,
+		DividendYield: dividendYield,
+		Years:         years,
+		Call:          call,
+	}, nil
+}
+
+// ExpiryTime 返回期权合约的到期时刻（到期日当地 16:00）。
+func ExpiryTime(c tigeropen.Contract) (time.Time, error) {
+	loc := tigeropen.MarketLocation(marketForCurrency(c.Currency))
+	for _, layout := range []string{"20060102", "2006-01-02"} {
+		if day, err := time.ParseInLocation(layout, c.Expiry, loc); err == nil {
+			return day.Add(16 * time.Hour), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: invalid expiry %q", ErrInvalidInput, c.Expiry)
+}
+
+func marketForCurrency(currency string) string {
+	switch strings.ToUpper(currency) {
+	case "HKD":
+		return tigeropen.MarketHK
+	case "CNH", "CNY":
+		return tigeropen.MarketCN
+	case "SGD":
+		return tigeropen.MarketSG
+	}
+	return tigeropen.MarketUS
+}
+
+func parsePutCall(s string) (bool, error) {
+	switch strings.ToUpper(s) {
+	case "CALL", "C":
+		return true, nil
+	case "PUT", "P":
+		return false, nil
+	}
+	return false, fmt.Errorf("%w: unknown put/call %q", ErrInvalidInput, s)
+}
+
+// WithVolatility 返回替换了波动率的输入副本。
+func (in Inputs) WithVolatility(vol float64) Inputs {
+	in.Volatility = vol
+	return in
+}
+
+func (in Inputs) validate() error {
+	if !(in.Spot > 0) || !(in.Strike > 0) {
+		return fmt.Errorf("%w: spot and strike must be positive", ErrInvalidInput)
+	}
+	if in.Years < 0 || math.IsNaN(in.Years) {
+		return fmt.Errorf("%w: negative time to expiry", ErrInvalidInput)
+	}
+	if in.Years > 0 && !(in.Volatility > 0) {
+		return fmt.Errorf("%w: volatility must be positive", ErrInvalidInput)
+	}
+	return nil
+}
+
+// intrinsic 返回立即行权价值。
+func (in Inputs) intrinsic() float64 {
+	if in.Call {
+		return math.Max(in.Spot-in.Strike, 0)
+	}
+	return math.Max(in.Strike-in.Spot, 0)
+}
+
+// lowerBound 返回与定价模型无关的无套利下限：立即行权价值与贴现远期内在价值中的较大者，美式与欧式期权均适用。
+func (in Inputs) lowerBound() float64 {
+	spot := in.Spot * math.Exp(-in.DividendYield*in.Years)
+	strike := in.Strike * math.Exp(-in.Rate*in.Years)
+	if in.Call {
+		return math.Max(in.intrinsic(), spot-strike)
+	}
+	return math.Max(in.intrinsic(), strike-spot)
+}
+
+// expiredGreeks 返回到期时的 Greeks，仅 Delta 非零。
+func (in Inputs) expiredGreeks() Greeks {
+	var g Greeks
+	switch {
+	case in.Call && in.Spot > in.Strike:
+		g.Delta = 1
+	case !in.Call && in.Spot < in.Strike:
+		g.Delta = -1
+	}
+	return g
+}
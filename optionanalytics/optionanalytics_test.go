@@ -0,0 +1,220 @@
+package optionanalytics
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	tigeropen "tigeropen/src"
+)
+
+// 参考值取自 Hull《期权、期货及其他衍生产品》的例题。
+
+func approx(t *testing.T, name string, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Errorf("%s = %.6f, want %.6f ± %g", name, got, want, tol)
+	}
+}
+
+func TestBlackScholesPrice(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Inputs
+		want float64
+	}{
+		{"hull call", Inputs{Spot: 42, Strike: 40, Rate: 0.1, Volatility: 0.2, Years: 0.5, Call: true}, 4.7594},
+		{"hull put", Inputs{Spot: 42, Strike: 40, Rate: 0.1, Volatility: 0.2, Years: 0.5}, 0.8086},
+		{"expired call", Inputs{Spot: 42, Strike: 40, Call: true}, 2},
+		{"expired put", Inputs{Spot: 42, Strike: 40}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BlackScholesPrice(tt.in)
+			if err != nil {
+				t.Fatalf("BlackScholesPrice: %v", err)
+			}
+			approx(t, "price", got, tt.want, 1e-4)
+		})
+	}
+}
+
+func TestBlackScholesGreeks(t *testing.T) {
+	// Hull 例题：S=49, K=50, r=5%, σ=20%, T=20 周；看跌一侧由平价关系推出。
+	base := Inputs{Spot: 49, Strike: 50, Rate: 0.05, Volatility: 0.2, Years: 0.3846}
+	tests := []struct {
+		name string
+		call bool
+		want Greeks
+	}{
+		{"call", true, Greeks{Delta: 0.5216, Gamma: 0.0655, Theta: -4.3054 / daysPerYear, Vega: 0.1211, Rho: 0.0891}},
+		{"put", false, Greeks{Delta: -0.4784, Gamma: 0.0655, Theta: -1.8530 / daysPerYear, Vega: 0.1211, Rho: -0.0996}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := base
+			in.Call = tt.call
+			got, err := BlackScholesGreeks(in)
+			if err != nil {
+				t.Fatalf("BlackScholesGreeks: %v", err)
+			}
+			approx(t, "delta", got.Delta, tt.want.Delta, 1e-4)
+			approx(t, "gamma", got.Gamma, tt.want.Gamma, 1e-4)
+			approx(t, "theta", got.Theta, tt.want.Theta, 1e-5)
+			approx(t, "vega", got.Vega, tt.want.Vega, 1e-4)
+			approx(t, "rho", got.Rho, tt.want.Rho, 1e-4)
+		})
+	}
+}
+
+func TestBinomialPrice(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    Inputs
+		steps int
+		want  float64
+		tol   float64
+	}{
+		// Hull 美式看跌例题：S=K=50, r=10%, σ=40%, T=5 个月，500 步约 4.283。
+		{"hull american put", Inputs{Spot: 50, Strike: 50, Rate: 0.1, Volatility: 0.4, Years: 5.0 / 12}, 500, 4.283, 1e-3},
+		// 无股息美式看涨不会提前行权，应收敛到 Black-Scholes。
+		{"american call equals european", Inputs{Spot: 42, Strike: 40, Rate: 0.1, Volatility: 0.2, Years: 0.5, Call: true}, 500, 4.7594, 5e-3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BinomialPrice(tt.in, tt.steps)
+			if err != nil {
+				t.Fatalf("BinomialPrice: %v", err)
+			}
+			approx(t, "price", got, tt.want, tt.tol)
+		})
+	}
+}
+
+func TestBinomialGreeksMatchBlackScholes(t *testing.T) {
+	in := Inputs{Spot: 49, Strike: 50, Rate: 0.05, Volatility: 0.2, Years: 0.3846, Call: true}
+	want, err := BlackScholesGreeks(in)
+	if err != nil {
+		t.Fatalf("BlackScholesGreeks: %v", err)
+	}
+	got, err := BinomialGreeks(in, 500)
+	if err != nil {
+		t.Fatalf("BinomialGreeks: %v", err)
+	}
+	approx(t, "delta", got.Delta, want.Delta, 2e-3)
+	approx(t, "gamma", got.Gamma, want.Gamma, 2e-3)
+	approx(t, "theta", got.Theta, want.Theta, 1e-4)
+	approx(t, "vega", got.Vega, want.Vega, 2e-3)
+	approx(t, "rho", got.Rho, want.Rho, 2e-3)
+}
+
+func TestImpliedVolatilityRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     Inputs
+		pricer Pricer
+		price  func(Inputs) (float64, error)
+	}{
+		{"black-scholes call", Inputs{Spot: 42, Strike: 40, Rate: 0.1, Volatility: 0.2, Years: 0.5, Call: true}, BlackScholes, BlackScholesPrice},
+		{"black-scholes put", Inputs{Spot: 100, Strike: 110, Rate: 0.03, DividendYield: 0.01, Volatility: 0.35, Years: 0.25}, BlackScholes, BlackScholesPrice},
+		{"binomial american put", Inputs{Spot: 50, Strike: 50, Rate: 0.1, Volatility: 0.4, Years: 5.0 / 12}, Binomial(500), Binomial(500)},
+		{"binomial high vol call", Inputs{Spot: 20, Strike: 25, Rate: 0.02, Volatility: 1.5, Years: 1, Call: true}, Binomial(200), Binomial(200)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := tt.price(tt.in)
+			if err != nil {
+				t.Fatalf("price: %v", err)
+			}
+			vol, err := ImpliedVolatility(price, tt.in.WithVolatility(0), tt.pricer)
+			if err != nil {
+				t.Fatalf("ImpliedVolatility: %v", err)
+			}
+			approx(t, "volatility", vol, tt.in.Volatility, 1e-6)
+		})
+	}
+}
+
+func TestImpliedVolatilityRejectsDegeneratePrices(t *testing.T) {
+	tests := []struct {
+		name   string
+		price  float64
+		in     Inputs
+		pricer Pricer
+	}{
+		{"zero price deep otm", 0, Inputs{Spot: 30, Strike: 40, Years: 0.5, Call: true}, nil},
+		{"intrinsic only", 2, Inputs{Spot: 42, Strike: 40, Years: 0.5, Call: true}, nil},
+		{"above spot", 50, Inputs{Spot: 42, Strike: 40, Years: 0.5, Call: true}, nil},
+		{"expired", 1, Inputs{Spot: 42, Strike: 40, Call: true}, nil},
+		// 高于内在价值 2 但低于贴现远期下限 S−K·e^{−rT}≈3.951。
+		{"below forward bound binomial", 3, Inputs{Spot: 42, Strike: 40, Rate: 0.1, Years: 0.5, Call: true}, Binomial(200)},
+		{"below forward bound black-scholes", 3, Inputs{Spot: 42, Strike: 40, Rate: 0.1, Years: 0.5, Call: true}, BlackScholes},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vol, err := ImpliedVolatility(tt.price, tt.in, tt.pricer)
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Fatalf("ImpliedVolatility = %v, %v; want ErrInvalidInput", vol, err)
+			}
+		})
+	}
+}
+
+func TestBinomialLowVolatility(t *testing.T) {
+	// σ√dt 小于 r·dt 时上涨概率大于 1，树不可用。
+	in := Inputs{Spot: 42, Strike: 40, Rate: 0.1, Volatility: 1e-4, Years: 0.5, Call: true}
+	if price, err := BinomialPrice(in, 200); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("BinomialPrice = %v, %v; want ErrInvalidInput", price, err)
+	}
+	if _, err := BinomialGreeks(in, 200); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("BinomialGreeks err = %v, want ErrInvalidInput", err)
+	}
+
+	// 略高于下限的价格仍可从更高的起始波动率反解，且解能还原价格。
+	vol, err := ImpliedVolatility(4, in.WithVolatility(0), Binomial(200))
+	if err != nil {
+		t.Fatalf("ImpliedVolatility: %v", err)
+	}
+	price, err := BinomialPrice(in.WithVolatility(vol), 200)
+	if err != nil {
+		t.Fatalf("BinomialPrice: %v", err)
+	}
+	approx(t, "repriced", price, 4, 1e-6)
+}
+
+func TestNewInputs(t *testing.T) {
+	strike := 150.0
+	contract := tigeropen.Contract{Symbol: "AAPL", SecType: "OPT", Expiry: "20240119", Strike: &strike, PutCall: "PUT"}
+	ny := tigeropen.MarketLocation(tigeropen.MarketUS)
+
+	in, err := NewInputs(contract, 155, 0.05, 0.01, time.Date(2024, 1, 18, 16, 0, 0, 0, ny))
+	if err != nil {
+		t.Fatalf("NewInputs: %v", err)
+	}
+	if in.Call || in.Strike != 150 || in.Spot != 155 || in.Rate != 0.05 || in.DividendYield != 0.01 {
+		t.Fatalf("NewInputs = %+v", in)
+	}
+	approx(t, "years", in.Years, 1/daysPerYear, 1e-9)
+
+	hk := contract
+	hk.Currency = "HKD"
+	expiry, err := ExpiryTime(hk)
+	if err != nil {
+		t.Fatalf("ExpiryTime: %v", err)
+	}
+	if want := time.Date(2024, 1, 19, 16, 0, 0, 0, tigeropen.MarketLocation(tigeropen.MarketHK)); !expiry.Equal(want) {
+		t.Fatalf("ExpiryTime = %v, want %v", expiry, want)
+	}
+
+	bad := contract
+	bad.PutCall = "STRADDLE"
+	if _, err := NewInputs(bad, 155, 0.05, 0, time.Now()); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("NewInputs with bad put/call err = %v", err)
+	}
+	bad = contract
+	bad.Strike = nil
+	if _, err := NewInputs(bad, 155, 0.05, 0, time.Now()); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("NewInputs without strike err = %v", err)
+	}
+}
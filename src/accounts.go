@@ -0,0 +1,80 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// 账户类型，网关返回的取值。
+const (
+	AccountTypeStandard = "STANDARD"
+	AccountTypePaper    = "PAPER"
+	AccountTypeGlobal   = "GLOBAL" // 环球账户（omnibus）。
+)
+
+// AccountProfile 是一个可交易账户的概要信息。
+type AccountProfile struct {
+	Account     string          `json:"account"`
+	Capability  string          `json:"capability"` // CASH、RegTMargin、PMGRN 等。
+	AccountType string          `json:"accountType"`
+	Status      string          `json:"status"`
+	Raw         json.RawMessage `json:"-"`
+}
+
+// IsMargin 表示账户是否为保证金账户。
+func (a AccountProfile) IsMargin() bool {
+	return a.Capability != "" && !strings.EqualFold(a.Capability, "CASH")
+}
+
+// IsPaper 表示账户是否为模拟账户。
+func (a AccountProfile) IsPaper() bool {
+	return strings.EqualFold(a.AccountType, AccountTypePaper)
+}
+
+type ManagedAccountsResult struct {
+	Response APIResponse
+	Accounts []AccountProfile
+}
+
+// AccountIDs 返回全部账户 ID，可直接用于 AssetsRequest.SubAccounts 等字段。
+func (r ManagedAccountsResult) AccountIDs() []string {
+	ids := make([]string, 0, len(r.Accounts))
+	for _, a := range r.Accounts {
+		ids = append(ids, a.Account)
+	}
+	return ids
+}
+
+// GetManagedAccounts 列出当前 tiger_id 可管理的全部账户。
+func (c *Client) GetManagedAccounts(ctx context.Context) (*ManagedAccountsResult, error) {
+	biz := map[string]interface{}{}
+	if c.cfg.SecretKey != "" {
+		biz["secret_key"] = c.cfg.SecretKey
+	}
+	if c.cfg.Lang != "" {
+		biz["lang"] = c.cfg.Lang
+	}
+	resp, err := c.call(ctx, "accounts", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("accounts", resp, 0, ""); err != nil {
+		return &ManagedAccountsResult{Response: resp}, err
+	}
+	items, err := unwrapItems(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode accounts data: %w", err)
+	}
+	result := &ManagedAccountsResult{Response: resp}
+	for _, raw := range items {
+		var item AccountProfile
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("decode account item: %w", err)
+		}
+		item.Raw = raw
+		result.Accounts = append(result.Accounts, item)
+	}
+	return result, nil
+}
@@ -0,0 +1,308 @@
+package tigeropen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ContractRequest 是 contract 的查询条件，期权需要同时指定 Expiry、Strike 与 PutCall。
+type ContractRequest struct {
+	Account   string
+	SecretKey string
+	Symbol    string
+	SecType   string
+	Currency  string
+	Exchange  string
+	Expiry    string
+	Strike    *float64
+	PutCall   string
+	Language  string
+}
+
+func (r ContractRequest) toBiz(cfg Config) map[string]interface{} {
+	account := r.Account
+	if account == "" {
+		account = cfg.Account
+	}
+	secret := r.SecretKey
+	if secret == "" {
+		secret = cfg.SecretKey
+	}
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if account != "" {
+		biz["account"] = account
+	}
+	if secret != "" {
+		biz["secret_key"] = secret
+	}
+	if r.Symbol != "" {
+		biz["symbol"] = r.Symbol
+	}
+	if r.SecType != "" {
+		biz["sec_type"] = r.SecType
+	}
+	if r.Currency != "" {
+		biz["currency"] = r.Currency
+	}
+	if r.Exchange != "" {
+		biz["exchange"] = r.Exchange
+	}
+	if r.Expiry != "" {
+		biz["expiry"] = r.Expiry
+	}
+	if r.Strike != nil {
+		biz["strike"] = *r.Strike
+	}
+	if r.PutCall != "" {
+		biz["right"] = r.PutCall
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+// ContractsRequest 是 contracts 的批量查询条件。
+type ContractsRequest struct {
+	Account   string
+	SecretKey string
+	Symbols   []string
+	SecType   string
+	Currency  string
+	Exchange  string
+	Language  string
+}
+
+func (r ContractsRequest) toBiz(cfg Config) map[string]interface{} {
+	account := r.Account
+	if account == "" {
+		account = cfg.Account
+	}
+	secret := r.SecretKey
+	if secret == "" {
+		secret = cfg.SecretKey
+	}
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if account != "" {
+		biz["account"] = account
+	}
+	if secret != "" {
+		biz["secret_key"] = secret
+	}
+	if len(r.Symbols) > 0 {
+		biz["symbols"] = r.Symbols
+	}
+	if r.SecType != "" {
+		biz["sec_type"] = r.SecType
+	}
+	if r.Currency != "" {
+		biz["currency"] = r.Currency
+	}
+	if r.Exchange != "" {
+		biz["exchange"] = r.Exchange
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+// DerivativeContractsRequest 是 quote_contract 的查询条件，用于期权、窝轮与牛熊证。
+type DerivativeContractsRequest struct {
+	Symbols  []string
+	SecType  string // OPT、WAR 或 IOPT。
+	Expiry   string
+	Language string
+}
+
+func (r DerivativeContractsRequest) toBiz(cfg Config) map[string]interface{} {
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if len(r.Symbols) > 0 {
+		biz["symbols"] = r.Symbols
+	}
+	if r.SecType != "" {
+		biz["sec_type"] = r.SecType
+	}
+	if r.Expiry != "" {
+		biz["expiry"] = r.Expiry
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+// TickSize 是价格区间 [Begin, End) 内的最小报价单位。
+type TickSize struct {
+	Begin    FloatOrString `json:"begin"`
+	End      FloatOrString `json:"end"`
+	Type     string        `json:"type,omitempty"`
+	TickSize FloatOrString `json:"tickSize"`
+}
+
+// TradingSession 是一段交易时段，时间为交易所当地时间的字符串表示。
+type TradingSession struct {
+	Type  string `json:"type,omitempty"`
+	Begin string `json:"begin"`
+	End   string `json:"end"`
+}
+
+// TradingSessions 兼容网关以数组或空字符串返回交易时段。
+type TradingSessions []TradingSession
+
+func (s *TradingSessions) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		*s = nil
+		return nil
+	}
+	var sessions []TradingSession
+	if err := json.Unmarshal(trimmed, &sessions); err != nil {
+		return err
+	}
+	*s = sessions
+	return nil
+}
+
+// ContractDetail 是合约查询返回的完整合约信息。
+type ContractDetail struct {
+	ContractID      int64           `json:"contractId"`
+	Identifier      string          `json:"identifier,omitempty"`
+	Symbol          string          `json:"symbol"`
+	Name            string          `json:"name,omitempty"`
+	SecType         string          `json:"secType"`
+	Currency        string          `json:"currency"`
+	Exchange        string          `json:"exchange,omitempty"`
+	PrimaryExchange string          `json:"primaryExchange,omitempty"`
+	Market          string          `json:"market,omitempty"`
+	LocalSymbol     string          `json:"localSymbol,omitempty"`
+	TradingClass    string          `json:"tradingClass,omitempty"`
+	Expiry          string          `json:"expiry,omitempty"`
+	Strike          FloatOrString   `json:"strike,omitempty"`
+	PutCall         string          `json:"right,omitempty"`
+	Multiplier      FloatOrString   `json:"multiplier,omitempty"`
+	LotSize         FloatOrString   `json:"lotSize,omitempty"`
+	MinTick         FloatOrString   `json:"minTick,omitempty"`
+	TickSizes       []TickSize      `json:"tickSizes,omitempty"`
+	Tradeable       bool            `json:"tradeable,omitempty"`
+	Shortable       bool            `json:"shortable,omitempty"`
+	ShortableCount  FloatOrString   `json:"shortableCount,omitempty"`
+	ShortMargin     FloatOrString   `json:"shortMargin,omitempty"`
+	ShortFeeRate    FloatOrString   `json:"shortFeeRate,omitempty"`
+	Marginable      bool            `json:"marginable,omitempty"`
+	LongInitMargin  FloatOrString   `json:"longInitialMargin,omitempty"`
+	LongMaintMargin FloatOrString   `json:"longMaintenanceMargin,omitempty"`
+	CloseOnly       bool            `json:"closeOnly,omitempty"`
+	LastTradingDate string          `json:"lastTradingDate,omitempty"`
+	TradingHours    TradingSessions `json:"tradingHours,omitempty"`
+	Raw             json.RawMessage `json:"-"`
+}
+
+// ToContract 将合约详情转为下单使用的 Contract，可直接填入 Order 或 ContractLeg。
+func (d ContractDetail) ToContract() Contract {
+	contract := Contract{
+		Symbol:      d.Symbol,
+		Currency:    d.Currency,
+		SecType:     d.SecType,
+		Exchange:    d.Exchange,
+		LocalSymbol: d.LocalSymbol,
+		Expiry:      d.Expiry,
+		PutCall:     d.PutCall,
+	}
+	if d.Strike != 0 {
+		strike := float64(d.Strike)
+		contract.Strike = &strike
+	}
+	if d.Multiplier != 0 {
+		contract.Multiplier = strconv.FormatFloat(float64(d.Multiplier), 'f', -1, 64)
+	}
+	return contract
+}
+
+// TickSizeFor 返回 price 所在区间的最小报价单位，没有匹配区间时返回 MinTick。
+func (d ContractDetail) TickSizeFor(price float64) float64 {
+	for _, t := range d.TickSizes {
+		if price >= float64(t.Begin) && (t.End == 0 || price < float64(t.End)) {
+			return float64(t.TickSize)
+		}
+	}
+	return float64(d.MinTick)
+}
+
+type ContractsResult struct {
+	Response  APIResponse
+	Contracts []ContractDetail
+}
+
+// GetContract 查询单个交易合约。
+func (c *Client) GetContract(ctx context.Context, req ContractRequest) (*ContractDetail, error) {
+	if req.Symbol == "" {
+		return nil, fmt.Errorf("%w: contract requires Symbol", ErrInvalidParameter)
+	}
+	result, err := c.queryContracts(ctx, "contract", req.toBiz(c.cfg))
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Contracts) == 0 {
+		return nil, fmt.Errorf("%w: contract %s", ErrNotFound, req.Symbol)
+	}
+	return &result.Contracts[0], nil
+}
+
+// GetContracts 批量查询交易合约。
+func (c *Client) GetContracts(ctx context.Context, req ContractsRequest) (*ContractsResult, error) {
+	if len(req.Symbols) == 0 {
+		return nil, fmt.Errorf("%w: contracts requires Symbols", ErrInvalidParameter)
+	}
+	return c.queryContracts(ctx, "contracts", req.toBiz(c.cfg))
+}
+
+// GetDerivativeContracts 通过行情接口 quote_contract 查询期权、窝轮与牛熊证合约。
+func (c *Client) GetDerivativeContracts(ctx context.Context, req DerivativeContractsRequest) (*ContractsResult, error) {
+	if len(req.Symbols) == 0 {
+		return nil, fmt.Errorf("%w: quote_contract requires Symbols", ErrInvalidParameter)
+	}
+	return c.queryContracts(ctx, "quote_contract", req.toBiz(c.cfg))
+}
+
+func (c *Client) queryContracts(ctx context.Context, method string, biz map[string]interface{}) (*ContractsResult, error) {
+	resp, err := c.call(ctx, method, biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(method, resp, 0, ""); err != nil {
+		return &ContractsResult{Response: resp}, err
+	}
+	items, err := unwrapItems(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s data: %w", method, err)
+	}
+	result := &ContractsResult{Response: resp}
+	for _, raw := range items {
+		var item ContractDetail
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("decode contract item: %w", err)
+		}
+		item.Raw = raw
+		result.Contracts = append(result.Contracts, item)
+	}
+	return result, nil
+}
@@ -0,0 +1,207 @@
+package tigeropen
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrorKind 是网关错误码的分类。
+type ErrorKind int
+
+const (
+	KindUnknown ErrorKind = iota
+	KindAuth
+	KindPermission
+	KindRateLimit
+	KindInvalidParameter
+	KindInsufficientFunds
+	KindMarketClosed
+	KindOrderRejected
+	KindNotFound
+	KindServer
+)
+
+// 可配合 errors.Is 使用的哨兵错误，*APIError 会按 Kind 匹配。
+var (
+	ErrAuthFailed        = errors.New("tigeropen: authentication failed")
+	ErrPermissionDenied  = errors.New("tigeropen: permission denied")
+	ErrRateLimited       = errors.New("tigeropen: rate limited")
+	ErrInvalidParameter  = errors.New("tigeropen: invalid parameter")
+	ErrInsufficientFunds = errors.New("tigeropen: insufficient funds")
+	ErrMarketClosed      = errors.New("tigeropen: market closed")
+	ErrOrderRejected     = errors.New("tigeropen: order rejected")
+	ErrNotFound          = errors.New("tigeropen: not found")
+	ErrServer            = errors.New("tigeropen: server error")
+)
+
+var kindNames = map[ErrorKind]string{
+	KindUnknown:           "unknown",
+	KindAuth:              "auth",
+	KindPermission:        "permission",
+	KindRateLimit:         "rate_limit",
+	KindInvalidParameter:  "invalid_parameter",
+	KindInsufficientFunds: "insufficient_funds",
+	KindMarketClosed:      "market_closed",
+	KindOrderRejected:     "order_rejected",
+	KindNotFound:          "not_found",
+	KindServer:            "server",
+}
+
+var kindSentinels = map[ErrorKind]error{
+	KindAuth:              ErrAuthFailed,
+	KindPermission:        ErrPermissionDenied,
+	KindRateLimit:         ErrRateLimited,
+	KindInvalidParameter:  ErrInvalidParameter,
+	KindInsufficientFunds: ErrInsufficientFunds,
+	KindMarketClosed:      ErrMarketClosed,
+	KindOrderRejected:     ErrOrderRejected,
+	KindNotFound:          ErrNotFound,
+	KindServer:            ErrServer,
+}
+
+func (k ErrorKind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("kind(%d)", int(k))
+}
+
+// ErrorCode 描述一个已知的网关错误码。
+type ErrorCode struct {
+	Code        int
+	Kind        ErrorKind
+	Description string
+}
+
+// errorCodes 收录网关文档中的错误码，交易类错误码较宽泛，需结合 message 再细分。
+var errorCodes = map[int]ErrorCode{
+	1:    {1, KindServer, "server error"},
+	2:    {2, KindInvalidParameter, "request parameter error"},
+	3:    {3, KindServer, "service unavailable"},
+	4:    {4, KindRateLimit, "rate limit exceeded"},
+	5:    {5, KindRateLimit, "request throttled"},
+	1000: {1000, KindInvalidParameter, "common parameter error"},
+	1010: {1010, KindInvalidParameter, "business parameter error"},
+	1100: {1100, KindOrderRejected, "global trade error"},
+	1200: {1200, KindOrderRejected, "US trade error"},
+	1300: {1300, KindOrderRejected, "HK trade error"},
+	2100: {2100, KindServer, "quote service error"},
+	2200: {2200, KindInvalidParameter, "quote parameter error"},
+	4000: {4000, KindInvalidParameter, "invalid request"},
+	4001: {4001, KindAuth, "tiger_id or signature invalid"},
+	4002: {4002, KindAuth, "token invalid or expired"},
+	4003: {4003, KindPermission, "permission denied"},
+	4004: {4004, KindNotFound, "account or resource not found"},
+}
+
+// messageKinds 用于从宽泛错误码的 message 中识别更具体的分类。
+var messageKinds = []struct {
+	substr string
+	kind   ErrorKind
+}{
+	{"insufficient", KindInsufficientFunds},
+	{"buying power", KindInsufficientFunds},
+	{"资金不足", KindInsufficientFunds},
+	{"购买力不足", KindInsufficientFunds},
+	{"market is closed", KindMarketClosed},
+	{"market closed", KindMarketClosed},
+	{"not trading hours", KindMarketClosed},
+	{"休市", KindMarketClosed},
+	{"非交易时段", KindMarketClosed},
+	{"rate limit", KindRateLimit},
+	{"too many requests", KindRateLimit},
+	{"频率", KindRateLimit},
+	{"permission", KindPermission},
+	{"权限", KindPermission},
+	{"not found", KindNotFound},
+	{"not exist", KindNotFound},
+	{"不存在", KindNotFound},
+}
+
+// LookupErrorCode 返回已收录错误码的描述。
+func LookupErrorCode(code int) (ErrorCode, bool) {
+	info, ok := errorCodes[code]
+	return info, ok
+}
+
+// classifyError 先按错误码分类，宽泛类别再按 message 细分。
+func classifyError(code int, message string) ErrorKind {
+	kind := KindUnknown
+	if info, ok := errorCodes[code]; ok {
+		kind = info.Kind
+	}
+	if kind != KindUnknown && kind != KindOrderRejected && kind != KindServer {
+		return kind
+	}
+	lower := strings.ToLower(message)
+	for _, mk := range messageKinds {
+		if strings.Contains(lower, mk.substr) {
+			return mk.kind
+		}
+	}
+	return kind
+}
+
+func classifyHTTPStatus(status int) ErrorKind {
+	switch {
+	case status == http.StatusUnauthorized:
+		return KindAuth
+	case status == http.StatusForbidden:
+		return KindPermission
+	case status == http.StatusNotFound:
+		return KindNotFound
+	case status == http.StatusTooManyRequests:
+		return KindRateLimit
+	case status >= 500:
+		return KindServer
+	case status >= 400:
+		return KindInvalidParameter
+	}
+	return KindUnknown
+}
+
+// APIError 表示网关返回的业务错误（envelope code 或 data 内 code 非 0）或非 200 的 HTTP 状态。
+type APIError struct {
+	Method     string
+	HTTPStatus int
+	Code       int // envelope 的 code。
+	DataCode   int // data 内的 code，例如 OrderIDData.Code。
+	Message    string
+	Kind       ErrorKind
+}
+
+func (e *APIError) Error() string {
+	if e.Code == 0 && e.DataCode == 0 && e.HTTPStatus != http.StatusOK {
+		return fmt.Sprintf("%s failed status=%d kind=%s msg=%s", e.Method, e.HTTPStatus, e.Kind, e.Message)
+	}
+	return fmt.Sprintf("%s rejected code=%d data_code=%d kind=%s msg=%s", e.Method, e.Code, e.DataCode, e.Kind, e.Message)
+}
+
+// Is 让 errors.Is 能够通过哨兵错误判断分类。
+func (e *APIError) Is(target error) bool {
+	sentinel, ok := kindSentinels[e.Kind]
+	return ok && sentinel == target
+}
+
+// checkResponse 在 envelope 或 data 内 code 非 0 时返回 *APIError。
+func checkResponse(method string, resp APIResponse, dataCode int, dataMessage string) error {
+	if resp.Code == 0 && dataCode == 0 {
+		return nil
+	}
+	apiErr := &APIError{
+		Method:     method,
+		HTTPStatus: resp.HTTPStatus,
+		Code:       resp.Code,
+		DataCode:   dataCode,
+		Message:    resp.Message,
+	}
+	if resp.Code != 0 {
+		apiErr.Kind = classifyError(resp.Code, resp.Message)
+	} else {
+		apiErr.Message = dataMessage
+		apiErr.Kind = classifyError(dataCode, dataMessage)
+	}
+	return apiErr
+}
@@ -0,0 +1,126 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// EstimateQuantityRequest 是 estimate_tradable_quantity 的查询条件，LMT/STP_LMT 需要 LimitPrice，STP/STP_LMT 需要 StopPrice。
+type EstimateQuantityRequest struct {
+	Account    string
+	SecretKey  string
+	Contract   Contract
+	Action     string
+	OrderType  string
+	LimitPrice *float64
+	StopPrice  *float64
+	SegType    string
+	Language   string
+}
+
+func (r EstimateQuantityRequest) toBiz(cfg Config) map[string]interface{} {
+	account := r.Account
+	if account == "" {
+		account = cfg.Account
+	}
+	secret := r.SecretKey
+	if secret == "" {
+		secret = cfg.SecretKey
+	}
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if account != "" {
+		biz["account"] = account
+	}
+	if secret != "" {
+		biz["secret_key"] = secret
+	}
+	for k, v := range r.Contract.toBiz() {
+		biz[k] = v
+	}
+	if r.Action != "" {
+		biz["action"] = r.Action
+	}
+	if r.OrderType != "" {
+		biz["order_type"] = r.OrderType
+	}
+	if r.LimitPrice != nil {
+		biz["limit_price"] = *r.LimitPrice
+	}
+	if r.StopPrice != nil {
+		biz["stop_price"] = *r.StopPrice
+	}
+	if r.SegType != "" {
+		biz["seg_type"] = r.SegType
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+func (r EstimateQuantityRequest) validate() error {
+	if r.Contract.Symbol == "" {
+		return fmt.Errorf("%w: estimate_tradable_quantity requires contract symbol", ErrInvalidParameter)
+	}
+	if r.Action == "" || r.OrderType == "" {
+		return fmt.Errorf("%w: estimate_tradable_quantity requires action and order type", ErrInvalidParameter)
+	}
+	switch r.OrderType {
+	case "LMT":
+		if r.LimitPrice == nil {
+			return fmt.Errorf("%w: LMT estimate requires limit price", ErrInvalidParameter)
+		}
+	case "STP":
+		if r.StopPrice == nil {
+			return fmt.Errorf("%w: STP estimate requires stop price", ErrInvalidParameter)
+		}
+	case "STP_LMT":
+		if r.LimitPrice == nil || r.StopPrice == nil {
+			return fmt.Errorf("%w: STP_LMT estimate requires limit and stop price", ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// TradableQuantity 是按给定价格与订单类型估算的可交易数量。
+type TradableQuantity struct {
+	TradableQuantity         float64         `json:"tradableQuantity"`
+	FinancingQuantity        float64         `json:"financingQuantity"`
+	PositionQuantity         float64         `json:"positionQuantity"`
+	TradablePositionQuantity float64         `json:"tradablePositionQuantity"`
+	Raw                      json.RawMessage `json:"-"`
+}
+
+type TradableQuantityResult struct {
+	Response APIResponse
+	Quantity TradableQuantity
+}
+
+// EstimateTradableQuantity 估算账户可买入或卖出的最大数量。
+func (c *Client) EstimateTradableQuantity(ctx context.Context, req EstimateQuantityRequest) (*TradableQuantityResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	biz := req.toBiz(c.cfg)
+	resp, err := c.call(ctx, "estimate_tradable_quantity", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("estimate_tradable_quantity", resp, 0, ""); err != nil {
+		return &TradableQuantityResult{Response: resp}, err
+	}
+	var payload TradableQuantity
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &payload); err != nil {
+			return nil, fmt.Errorf("decode estimate_tradable_quantity data: %w", err)
+		}
+		payload.Raw = resp.Data
+	}
+	return &TradableQuantityResult{Response: resp, Quantity: payload}, nil
+}
@@ -0,0 +1,106 @@
+package tigeropen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// knownCurrencies 是网关支持的 ISO 4217 币种。
+var knownCurrencies = map[string]bool{
+	"USD": true,
+	"HKD": true,
+	"CNH": true,
+	"CNY": true,
+	"SGD": true,
+	"AUD": true,
+	"NZD": true,
+	"CAD": true,
+	"EUR": true,
+	"GBP": true,
+	"JPY": true,
+	"CHF": true,
+}
+
+// IsKnownCurrency 判断币种代码是否在 SDK 收录的 ISO 币种列表中（不区分大小写）。
+func IsKnownCurrency(code string) bool {
+	return knownCurrencies[strings.ToUpper(code)]
+}
+
+// ForexOrderRequest 是 place_forex_order 的请求参数，将 SourceAmount 的 SourceCurrency 兑换为 TargetCurrency。
+type ForexOrderRequest struct {
+	Account        string
+	SecretKey      string
+	SourceCurrency string
+	TargetCurrency string
+	SourceAmount   Decimal
+	SegType        string
+	Language       string
+}
+
+func (r ForexOrderRequest) toBiz(cfg Config) map[string]interface{} {
+	account := r.Account
+	if account == "" {
+		account = cfg.Account
+	}
+	secret := r.SecretKey
+	if secret == "" {
+		secret = cfg.SecretKey
+	}
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if account != "" {
+		biz["account"] = account
+	}
+	if secret != "" {
+		biz["secret_key"] = secret
+	}
+	if r.SourceCurrency != "" {
+		biz["source_currency"] = strings.ToUpper(r.SourceCurrency)
+	}
+	if r.TargetCurrency != "" {
+		biz["target_currency"] = strings.ToUpper(r.TargetCurrency)
+	}
+	if r.SourceAmount != "" {
+		biz["source_amount"] = r.SourceAmount.jsonNumber()
+	}
+	if r.SegType != "" {
+		biz["seg_type"] = r.SegType
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+func (r ForexOrderRequest) validate() error {
+	if !IsKnownCurrency(r.SourceCurrency) {
+		return fmt.Errorf("%w: unknown source currency %q", ErrInvalidParameter, r.SourceCurrency)
+	}
+	if !IsKnownCurrency(r.TargetCurrency) {
+		return fmt.Errorf("%w: unknown target currency %q", ErrInvalidParameter, r.TargetCurrency)
+	}
+	if strings.EqualFold(r.SourceCurrency, r.TargetCurrency) {
+		return fmt.Errorf("%w: source and target currency are both %q", ErrInvalidParameter, r.SourceCurrency)
+	}
+	amount, err := ParseDecimal(string(r.SourceAmount))
+	if err != nil {
+		return fmt.Errorf("%w: source amount: %v", ErrInvalidParameter, err)
+	}
+	if !amount.IsPositive() {
+		return fmt.Errorf("%w: source amount must be positive, got %q", ErrInvalidParameter, r.SourceAmount)
+	}
+	return nil
+}
+
+// PlaceForexOrder 在账户内提交换汇订单，返回结构与 PlaceOrder 一致。
+func (c *Client) PlaceForexOrder(ctx context.Context, req ForexOrderRequest) (*OrderResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	return c.callOrder(ctx, "place_forex_order", req.toBiz(c.cfg))
+}
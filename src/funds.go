@@ -0,0 +1,222 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const defaultFundPageSize = 100
+
+// FundsRequest 是资金流水查询条件，StartDate/EndDate 以 yyyy-MM-dd 传给网关，Start 与 Limit 为偏移分页参数。
+type FundsRequest struct {
+	Account   string
+	SecretKey string
+	StartDate time.Time
+	EndDate   time.Time
+	SegType   string
+	Currency  string
+	FundType  string // 如 DEPOSIT、WITHDRAW、DIVIDEND、INTEREST、FEE，留空表示全部。
+	Start     int
+	Limit     int
+	Language  string
+}
+
+func (r FundsRequest) toBiz(cfg Config) map[string]interface{} {
+	account := r.Account
+	if account == "" {
+		account = cfg.Account
+	}
+	secret := r.SecretKey
+	if secret == "" {
+		secret = cfg.SecretKey
+	}
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if account != "" {
+		biz["account"] = account
+	}
+	if secret != "" {
+		biz["secret_key"] = secret
+	}
+	if !r.StartDate.IsZero() {
+		biz["start_date"] = r.StartDate.Format("2006-01-02")
+	}
+	if !r.EndDate.IsZero() {
+		biz["end_date"] = r.EndDate.Format("2006-01-02")
+	}
+	if r.SegType != "" {
+		biz["seg_type"] = r.SegType
+	}
+	if r.Currency != "" {
+		biz["currency"] = r.Currency
+	}
+	if r.FundType != "" {
+		biz["fund_type"] = r.FundType
+	}
+	if r.Start > 0 {
+		biz["start"] = r.Start
+	}
+	if r.Limit > 0 {
+		biz["limit"] = r.Limit
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+func (r FundsRequest) validate() error {
+	if !r.StartDate.IsZero() && !r.EndDate.IsZero() && r.EndDate.Before(r.StartDate) {
+		return fmt.Errorf("%w: end date before start date", ErrInvalidParameter)
+	}
+	if r.Start < 0 || r.Limit < 0 {
+		return fmt.Errorf("%w: start and limit must not be negative", ErrInvalidParameter)
+	}
+	return nil
+}
+
+// FundEntry 是一条资金流水，BalanceAfter 为该笔入账后的余额（网关未返回时为空）。
+type FundEntry struct {
+	ID           int64           `json:"id"`
+	RefID        string          `json:"refId,omitempty"`
+	Type         string          `json:"type"`
+	Description  string          `json:"desc,omitempty"`
+	Currency     string          `json:"currency"`
+	SegType      string          `json:"segType,omitempty"`
+	Amount       Decimal         `json:"amount"`
+	BalanceAfter Decimal         `json:"balance,omitempty"`
+	Status       string          `json:"status,omitempty"`
+	BusinessTime time.Time       `json:"-"`
+	UpdatedAt    time.Time       `json:"-"`
+	Raw          json.RawMessage `json:"-"`
+}
+
+func (f *FundEntry) UnmarshalJSON(data []byte) error {
+	type alias FundEntry
+	aux := struct {
+		*alias
+		BusinessDate int64 `json:"businessDate"`
+		UpdatedAt    int64 `json:"updatedAt"`
+	}{alias: (*alias)(f)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	f.BusinessTime = timeFromMillis(aux.BusinessDate)
+	f.UpdatedAt = timeFromMillis(aux.UpdatedAt)
+	return nil
+}
+
+type FundsData struct {
+	Items []FundEntry
+	Total int
+}
+
+type FundsResult struct {
+	Response APIResponse
+	Funds    FundsData
+}
+
+type fundsWrapper struct {
+	Items []json.RawMessage `json:"items"`
+	Total int               `json:"total"`
+}
+
+// GetFundingHistory 查询出入金记录（transfer_fund）。
+func (c *Client) GetFundingHistory(ctx context.Context, req FundsRequest) (*FundsResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	return c.queryFunds(ctx, "transfer_fund", req)
+}
+
+// GetFundDetails 查询资金明细（fund_details），包括出入金、分红、利息与各类费用。
+func (c *Client) GetFundDetails(ctx context.Context, req FundsRequest) (*FundsResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	return c.queryFunds(ctx, "fund_details", req)
+}
+
+// IterateFundDetails 按 Start/Limit 偏移逐页拉取资金明细，Limit 为 0 时每页 100 条。
+// fn 返回 ErrStopIteration 时提前结束并返回 nil。
+func (c *Client) IterateFundDetails(ctx context.Context, req FundsRequest, fn func(FundEntry) error) error {
+	if err := req.validate(); err != nil {
+		return err
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultFundPageSize
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result, err := c.queryFunds(ctx, "fund_details", req)
+		if err != nil {
+			return err
+		}
+		for _, item := range result.Funds.Items {
+			if err := fn(item); err != nil {
+				if errors.Is(err, ErrStopIteration) {
+					return nil
+				}
+				return err
+			}
+		}
+		req.Start += len(result.Funds.Items)
+		if len(result.Funds.Items) == 0 {
+			return nil
+		}
+		// 网关可能把单页条数压到 Limit 以下，有 Total 时以 Total 为准，否则以短页判断结束。
+		if result.Funds.Total > 0 {
+			if req.Start >= result.Funds.Total {
+				return nil
+			}
+		} else if len(result.Funds.Items) < req.Limit {
+			return nil
+		}
+	}
+}
+
+func (c *Client) queryFunds(ctx context.Context, method string, req FundsRequest) (*FundsResult, error) {
+	biz := req.toBiz(c.cfg)
+	if segType, ok := biz["seg_type"]; ok && method == "fund_details" {
+		// fund_details 以列表形式接收分段。
+		delete(biz, "seg_type")
+		biz["seg_types"] = []interface{}{segType}
+	}
+	resp, err := c.call(ctx, method, biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(method, resp, 0, ""); err != nil {
+		return &FundsResult{Response: resp}, err
+	}
+	var wrapper fundsWrapper
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &wrapper); err != nil {
+			// 兼容 data 直接为数组的场景。
+			var items []json.RawMessage
+			if errArray := json.Unmarshal(resp.Data, &items); errArray != nil {
+				return nil, fmt.Errorf("decode %s data: %w", method, err)
+			}
+			wrapper.Items = items
+		}
+	}
+	result := &FundsResult{Response: resp, Funds: FundsData{Total: wrapper.Total}}
+	for _, raw := range wrapper.Items {
+		var item FundEntry
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("decode fund item: %w", err)
+		}
+		item.Raw = raw
+		result.Funds.Items = append(result.Funds.Items, item)
+	}
+	return result, nil
+}
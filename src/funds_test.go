@@ -0,0 +1,104 @@
+package tigeropen
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// pagedGateway 模拟分页网关：page 根据请求的 biz_content 返回 data，调用次数计入 requests。
+func pagedGateway(t *testing.T, requests *int, page func(biz map[string]interface{}) interface{}) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		var biz map[string]interface{}
+		raw, _ := params["biz_content"].(string)
+		if err := json.Unmarshal([]byte(raw), &biz); err != nil {
+			t.Errorf("decode biz_content: %v", err)
+			return
+		}
+		*requests++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"code": 0, "data": page(biz)})
+	}))
+	t.Cleanup(server.Close)
+
+	tigerKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return newSignTestClient(t, server.URL, tigerKey, SignVerifyOff, &bytes.Buffer{})
+}
+
+// fundPages 按 start/limit 切分 n 条流水，单页最多 pageCap 条；total 为 0 时不返回 total。
+func fundPages(n, pageCap, total int) func(map[string]interface{}) interface{} {
+	return func(biz map[string]interface{}) interface{} {
+		start, _ := biz["start"].(float64)
+		limit, _ := biz["limit"].(float64)
+		size := int(limit)
+		if pageCap > 0 && size > pageCap {
+			size = pageCap
+		}
+		items := []map[string]interface{}{}
+		for i := int(start); i < n && len(items) < size; i++ {
+			items = append(items, map[string]interface{}{"id": i + 1, "amount": "1"})
+		}
+		data := map[string]interface{}{"items": items}
+		if total > 0 {
+			data["total"] = total
+		}
+		return data
+	}
+}
+
+func TestIterateFundDetails(t *testing.T) {
+	tests := []struct {
+		name         string
+		limit        int
+		page         func(map[string]interface{}) interface{}
+		stopAfter    int
+		wantIDs      int
+		wantRequests int
+	}{
+		// 网关把单页压到 2 条，但 total 表明仍有数据，应继续翻页。
+		{"short page with total", 10, fundPages(5, 2, 5), 0, 5, 3},
+		// 无 total 时以短页判断结束。
+		{"short page without total", 2, fundPages(5, 0, 0), 0, 5, 3},
+		// total 偏大但网关已无数据，空页结束。
+		{"empty page", 3, fundPages(3, 0, 10), 0, 3, 2},
+		{"stop iteration", 2, fundPages(5, 0, 5), 3, 3, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			client := pagedGateway(t, &requests, tt.page)
+			var ids []int64
+			err := client.IterateFundDetails(context.Background(), FundsRequest{Limit: tt.limit}, func(f FundEntry) error {
+				ids = append(ids, f.ID)
+				if tt.stopAfter > 0 && len(ids) == tt.stopAfter {
+					return ErrStopIteration
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("IterateFundDetails: %v", err)
+			}
+			if len(ids) != tt.wantIDs || requests != tt.wantRequests {
+				t.Fatalf("got %d entries in %d requests, want %d in %d", len(ids), requests, tt.wantIDs, tt.wantRequests)
+			}
+			for i, id := range ids {
+				if id != int64(i+1) {
+					t.Fatalf("entries out of order: %v", ids)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package tigeropen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrStopIteration 可由迭代回调返回以提前结束遍历，迭代函数本身会返回 nil。
+var ErrStopIteration = errors.New("tigeropen: stop iteration")
+
+// ErrRecordLimitReached 表示 Collect 类函数收集到的记录达到上限后仍有剩余数据。
+var ErrRecordLimitReached = errors.New("tigeropen: record limit reached")
+
+// OrderQuery 是订单查询使用的网关方法。
+type OrderQuery string
+
+const (
+	OrderQueryAll      OrderQuery = "orders"
+	OrderQueryActive   OrderQuery = "active_orders"
+	OrderQueryInactive OrderQuery = "inactive_orders"
+	OrderQueryFilled   OrderQuery = "filled_orders"
+)
+
+func (q OrderQuery) validate(req OrdersRequest) error {
+	switch q {
+	case OrderQueryAll, OrderQueryActive, OrderQueryInactive:
+		return nil
+	case OrderQueryFilled:
+		if req.StartTime == nil || req.EndTime == nil {
+			return fmt.Errorf("%w: filled_orders requires StartTime and EndTime", ErrInvalidParameter)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: unknown order query %q", ErrInvalidParameter, string(q))
+}
+
+// IterateOrders 沿 nextPageToken 逐页拉取订单并对每条记录调用 fn，req.Limit 作为每页大小。
+// fn 返回 ErrStopIteration 时提前结束并返回 nil，返回其它错误时原样返回。
+func (c *Client) IterateOrders(ctx context.Context, req OrdersRequest, fn func(OrderRecord) error) error {
+	return c.paginateOrders(ctx, string(OrderQueryAll), req, fn)
+}
+
+// CollectOrders 拉取全部分页订单，最多保留 max 条；超过上限时返回已收集的记录与 ErrRecordLimitReached。
+func (c *Client) CollectOrders(ctx context.Context, req OrdersRequest, max int) ([]OrderRecord, error) {
+	return c.collectOrders(ctx, string(OrderQueryAll), req, max)
+}
+
+// IterateOrdersFor 与 IterateOrders 相同，但使用 query 指定的订单查询方法。
+func (c *Client) IterateOrdersFor(ctx context.Context, query OrderQuery, req OrdersRequest, fn func(OrderRecord) error) error {
+	if err := query.validate(req); err != nil {
+		return err
+	}
+	return c.paginateOrders(ctx, string(query), req, fn)
+}
+
+// CollectOrdersFor 与 CollectOrders 相同，但使用 query 指定的订单查询方法。
+func (c *Client) CollectOrdersFor(ctx context.Context, query OrderQuery, req OrdersRequest, max int) ([]OrderRecord, error) {
+	if err := query.validate(req); err != nil {
+		return nil, err
+	}
+	return c.collectOrders(ctx, string(query), req, max)
+}
+
+func (c *Client) paginateOrders(ctx context.Context, method string, req OrdersRequest, fn func(OrderRecord) error) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result, err := c.queryOrders(ctx, method, req)
+		if err != nil {
+			return err
+		}
+		for _, item := range result.Orders.Items {
+			if err := fn(item); err != nil {
+				if errors.Is(err, ErrStopIteration) {
+					return nil
+				}
+				return err
+			}
+		}
+		next := result.Orders.NextPageToken
+		if next == "" || len(result.Orders.Items) == 0 {
+			return nil
+		}
+		if next == req.NextPageToken {
+			return fmt.Errorf("%s pagination stalled at token %q", method, next)
+		}
+		req.NextPageToken = next
+	}
+}
+
+func (c *Client) collectOrders(ctx context.Context, method string, req OrdersRequest, max int) ([]OrderRecord, error) {
+	if max <= 0 {
+		return nil, fmt.Errorf("%w: max must be positive, got %d", ErrInvalidParameter, max)
+	}
+	var out []OrderRecord
+	err := c.paginateOrders(ctx, method, req, func(item OrderRecord) error {
+		if len(out) >= max {
+			return ErrRecordLimitReached
+		}
+		out = append(out, item)
+		return nil
+	})
+	return out, err
+}
@@ -0,0 +1,41 @@
+//go:build go1.23
+
+package tigeropen
+
+import (
+	"context"
+	"iter"
+)
+
+// OrdersSeq 以 iter.Seq2 形式遍历全部分页订单，出错时产出一次零值记录与错误后结束。
+//
+//	for order, err := range client.OrdersSeq(ctx, req) {
+//		if err != nil { ... }
+//	}
+func (c *Client) OrdersSeq(ctx context.Context, req OrdersRequest) iter.Seq2[OrderRecord, error] {
+	return c.ordersSeq(ctx, string(OrderQueryAll), req)
+}
+
+// OrdersSeqFor 与 OrdersSeq 相同，但使用 query 指定的订单查询方法。
+func (c *Client) OrdersSeqFor(ctx context.Context, query OrderQuery, req OrdersRequest) iter.Seq2[OrderRecord, error] {
+	if err := query.validate(req); err != nil {
+		return func(yield func(OrderRecord, error) bool) {
+			yield(OrderRecord{}, err)
+		}
+	}
+	return c.ordersSeq(ctx, string(query), req)
+}
+
+func (c *Client) ordersSeq(ctx context.Context, method string, req OrdersRequest) iter.Seq2[OrderRecord, error] {
+	return func(yield func(OrderRecord, error) bool) {
+		err := c.paginateOrders(ctx, method, req, func(item OrderRecord) error {
+			if !yield(item, nil) {
+				return ErrStopIteration
+			}
+			return nil
+		})
+		if err != nil {
+			yield(OrderRecord{}, err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package tigeropen
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// orderPages 以两条一页返回 n 条订单，nextPageToken 为下一页的起始下标。
+func orderPages(n int) func(map[string]interface{}) interface{} {
+	return func(biz map[string]interface{}) interface{} {
+		token, _ := biz["next_page_token"].(string)
+		start, _ := strconv.Atoi(token)
+		items := []map[string]interface{}{}
+		for i := start; i < n && i < start+2; i++ {
+			items = append(items, map[string]interface{}{"id": i + 1, "symbol": "AAPL"})
+		}
+		next := ""
+		if start+2 < n {
+			next = strconv.Itoa(start + 2)
+		}
+		return map[string]interface{}{"items": items, "nextPageToken": next}
+	}
+}
+
+func TestIterateOrdersStalledToken(t *testing.T) {
+	requests := 0
+	client := pagedGateway(t, &requests, func(map[string]interface{}) interface{} {
+		return map[string]interface{}{"items": []map[string]interface{}{{"id": 1}}, "nextPageToken": "same"}
+	})
+	err := client.IterateOrders(context.Background(), OrdersRequest{}, func(OrderRecord) error { return nil })
+	if err == nil || !strings.Contains(err.Error(), "stalled") {
+		t.Fatalf("IterateOrders err = %v, want stalled pagination", err)
+	}
+	if requests != 2 {
+		t.Fatalf("requests = %d, want 2", requests)
+	}
+}
+
+func TestCollectOrdersLimit(t *testing.T) {
+	const max = 5
+	tests := []struct {
+		name    string
+		records int
+		wantErr error
+	}{
+		{"exactly max", max, nil},
+		{"max plus one", max + 1, ErrRecordLimitReached},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			client := pagedGateway(t, &requests, orderPages(tt.records))
+			orders, err := client.CollectOrders(context.Background(), OrdersRequest{}, max)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CollectOrders err = %v, want %v", err, tt.wantErr)
+			}
+			if len(orders) != max {
+				t.Fatalf("collected %d orders, want %d", len(orders), max)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PrimeAssetsRequest 是 prime_assets 的查询条件。
+type PrimeAssetsRequest struct {
+	Account      string
+	SecretKey    string
+	BaseCurrency string
+	Consolidated *bool
+	Language     string
+}
+
+func (r PrimeAssetsRequest) toBiz(cfg Config) map[string]interface{} {
+	account := r.Account
+	if account == "" {
+		account = cfg.Account
+	}
+	secret := r.SecretKey
+	if secret == "" {
+		secret = cfg.SecretKey
+	}
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if account != "" {
+		biz["account"] = account
+	}
+	if secret != "" {
+		biz["secret_key"] = secret
+	}
+	if r.BaseCurrency != "" {
+		biz["base_currency"] = r.BaseCurrency
+	}
+	if r.Consolidated != nil {
+		biz["consolidated"] = *r.Consolidated
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+// PrimeAssets 是综合账户资产，按证券（S）与期货（C）分段。
+type PrimeAssets struct {
+	Account         string          `json:"accountId"`
+	UpdateTimestamp int64           `json:"updateTimestamp,omitempty"`
+	Segments        []AssetSegment  `json:"segments"`
+	Raw             json.RawMessage `json:"-"`
+}
+
+// Segment 返回指定分段（S 或 C），不存在时返回 nil。
+func (p PrimeAssets) Segment(category string) *AssetSegment {
+	for i := range p.Segments {
+		if p.Segments[i].Category == category {
+			return &p.Segments[i]
+		}
+	}
+	return nil
+}
+
+// AssetSegment 是单个分段的资产与保证金数据。
+type AssetSegment struct {
+	Category                   string          `json:"category"`
+	Capability                 string          `json:"capability,omitempty"`
+	Currency                   string          `json:"currency"`
+	CashBalance                float64         `json:"cashBalance"`
+	CashAvailableForTrade      float64         `json:"cashAvailableForTrade"`
+	CashAvailableForWithdrawal float64         `json:"cashAvailableForWithdrawal"`
+	GrossPositionValue         float64         `json:"grossPositionValue"`
+	EquityWithLoan             float64         `json:"equityWithLoan"`
+	NetLiquidation             float64         `json:"netLiquidation"`
+	InitMargin                 float64         `json:"initMargin"`
+	MaintainMargin             float64         `json:"maintainMargin"`
+	OvernightMargin            float64         `json:"overnightMargin"`
+	UnrealizedPnL              float64         `json:"unrealizedPL"`
+	RealizedPnL                float64         `json:"realizedPL"`
+	ExcessLiquidation          float64         `json:"excessLiquidation"`
+	OvernightLiquidation       float64         `json:"overnightLiquidation"`
+	BuyingPower                float64         `json:"buyingPower"`
+	Leverage                   float64         `json:"leverage"`
+	Cushion                    float64         `json:"cushion,omitempty"`
+	LockedFunds                float64         `json:"lockedFunds,omitempty"`
+	Uncollected                float64         `json:"uncollected,omitempty"`
+	ConsolidatedSegTypes       []string        `json:"consolidatedSegTypes,omitempty"`
+	CurrencyAssets             []CurrencyAsset `json:"currencyAssets,omitempty"`
+}
+
+// CurrencyAsset 是分段内单一币种的资产。
+type CurrencyAsset struct {
+	Currency              string  `json:"currency"`
+	CashBalance           float64 `json:"cashBalance"`
+	CashAvailableForTrade float64 `json:"cashAvailableForTrade"`
+	GrossPositionValue    float64 `json:"grossPositionValue"`
+	StockMarketValue      float64 `json:"stockMarketValue,omitempty"`
+	FuturesMarketValue    float64 `json:"futuresMarketValue,omitempty"`
+	OptionMarketValue     float64 `json:"optionMarketValue,omitempty"`
+	UnrealizedPnL         float64 `json:"unrealizedPL,omitempty"`
+	RealizedPnL           float64 `json:"realizedPL,omitempty"`
+}
+
+type PrimeAssetsResult struct {
+	Response APIResponse
+	Assets   PrimeAssets
+}
+
+// AnalyticsAssetsRequest 是历史资产分析的查询条件，日期按 yyyy-MM-dd 传给网关。
+type AnalyticsAssetsRequest struct {
+	Account    string
+	SecretKey  string
+	StartDate  time.Time
+	EndDate    time.Time
+	SegType    string
+	Currency   string
+	SubAccount string
+	Language   string
+}
+
+func (r AnalyticsAssetsRequest) toBiz(cfg Config) map[string]interface{} {
+	account := r.Account
+	if account == "" {
+		account = cfg.Account
+	}
+	secret := r.SecretKey
+	if secret == "" {
+		secret = cfg.SecretKey
+	}
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if account != "" {
+		biz["account"] = account
+	}
+	if secret != "" {
+		biz["secret_key"] = secret
+	}
+	if !r.StartDate.IsZero() {
+		biz["start_date"] = r.StartDate.Format("2006-01-02")
+	}
+	if !r.EndDate.IsZero() {
+		biz["end_date"] = r.EndDate.Format("2006-01-02")
+	}
+	if r.SegType != "" {
+		biz["seg_type"] = r.SegType
+	}
+	if r.Currency != "" {
+		biz["currency"] = r.Currency
+	}
+	if r.SubAccount != "" {
+		biz["sub_account"] = r.SubAccount
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+// AnalyticsAssets 是区间收益汇总与逐日资产曲线。
+type AnalyticsAssets struct {
+	Summary AssetsSummary       `json:"summary"`
+	History []DailyAssetSummary `json:"history"`
+	Raw     json.RawMessage     `json:"-"`
+}
+
+type AssetsSummary struct {
+	PnL                float64 `json:"pnl"`
+	PnLPercentage      float64 `json:"pnlPercentage"`
+	AnnualizedReturn   float64 `json:"annualizedReturn"`
+	OverUserPercentage float64 `json:"overUserPercentage,omitempty"`
+}
+
+// DailyAssetSummary 是资产曲线上的一个交易日。
+type DailyAssetSummary struct {
+	Date               time.Time `json:"-"`
+	Asset              float64   `json:"asset"`
+	PnL                float64   `json:"pnl"`
+	PnLPercentage      float64   `json:"pnlPercentage"`
+	CashBalance        float64   `json:"cashBalance"`
+	GrossPositionValue float64   `json:"grossPositionValue"`
+	Deposit            float64   `json:"deposit"`
+	Withdrawal         float64   `json:"withdrawal"`
+}
+
+func (d *DailyAssetSummary) UnmarshalJSON(data []byte) error {
+	type alias DailyAssetSummary
+	aux := struct {
+		*alias
+		Date int64 `json:"date"`
+	}{alias: (*alias)(d)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	d.Date = timeFromMillis(aux.Date)
+	return nil
+}
+
+type AnalyticsAssetsResult struct {
+	Response APIResponse
+	Assets   AnalyticsAssets
+}
+
+// GetPrimeAssets 查询综合账户分段与分币种资产。
+func (c *Client) GetPrimeAssets(ctx context.Context, req PrimeAssetsRequest) (*PrimeAssetsResult, error) {
+	biz := req.toBiz(c.cfg)
+	resp, err := c.call(ctx, "prime_assets", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("prime_assets", resp, 0, ""); err != nil {
+		return &PrimeAssetsResult{Response: resp}, err
+	}
+	var payload PrimeAssets
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &payload); err != nil {
+			return nil, fmt.Errorf("decode prime_assets data: %w", err)
+		}
+		payload.Raw = resp.Data
+	}
+	return &PrimeAssetsResult{Response: resp, Assets: payload}, nil
+}
+
+// GetAnalyticsAssets 查询历史资产分析，对应网关的 analytics_asset 方法。
+func (c *Client) GetAnalyticsAssets(ctx context.Context, req AnalyticsAssetsRequest) (*AnalyticsAssetsResult, error) {
+	if !req.StartDate.IsZero() && !req.EndDate.IsZero() && req.EndDate.Before(req.StartDate) {
+		return nil, fmt.Errorf("%w: end date before start date", ErrInvalidParameter)
+	}
+	biz := req.toBiz(c.cfg)
+	resp, err := c.call(ctx, "analytics_asset", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("analytics_asset", resp, 0, ""); err != nil {
+		return &AnalyticsAssetsResult{Response: resp}, err
+	}
+	var payload AnalyticsAssets
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &payload); err != nil {
+			return nil, fmt.Errorf("decode analytics_asset data: %w", err)
+		}
+		payload.Raw = resp.Data
+	}
+	return &AnalyticsAssetsResult{Response: resp, Assets: payload}, nil
+}
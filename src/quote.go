@@ -0,0 +1,284 @@
+package tigeropen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 行情市场代码。
+const (
+	MarketUS  = "US"
+	MarketHK  = "HK"
+	MarketCN  = "CN"
+	MarketSG  = "SG"
+	MarketAll = "ALL"
+)
+
+// marketZones 是各市场交易所所在时区，LoadLocation 失败时退回固定偏移。
+var marketZones = map[string]struct {
+	name   string
+	offset int
+}{
+	MarketUS: {"America/New_York", -5 * 3600},
+	MarketHK: {"Asia/Hong_Kong", 8 * 3600},
+	MarketCN: {"Asia/Shanghai", 8 * 3600},
+	MarketSG: {"Asia/Singapore", 8 * 3600},
+}
+
+// MarketLocation 返回市场所在时区，未知市场返回 UTC。
+func MarketLocation(market string) *time.Location {
+	zone, ok := marketZones[strings.ToUpper(market)]
+	if !ok {
+		return time.UTC
+	}
+	if loc, err := time.LoadLocation(zone.name); err == nil {
+		return loc
+	}
+	return time.FixedZone(zone.name, zone.offset)
+}
+
+// QuoteClient 提供行情接口，与 Client 共用签名、限流与重试。
+type QuoteClient struct {
+	client *Client
+}
+
+// Quote 返回共用当前 Client 配置的行情客户端。
+func (c *Client) Quote() *QuoteClient {
+	return &QuoteClient{client: c}
+}
+
+// biz 返回行情请求的公共参数。
+func (q *QuoteClient) biz() map[string]interface{} {
+	biz := map[string]interface{}{}
+	if q.client.cfg.Lang != "" {
+		biz["lang"] = q.client.cfg.Lang
+	}
+	return biz
+}
+
+// batchStrings 按 size 切分 items，保持原有顺序。
+func batchStrings(items []string, size int) [][]string {
+	var batches [][]string
+	for len(items) > size {
+		batches = append(batches, items[:size])
+		items = items[size:]
+	}
+	if len(items) > 0 {
+		batches = append(batches, items)
+	}
+	return batches
+}
+
+// decodeSeries 解码按标的分组的行情数据，data 可以是数组或单个对象（对象内的 items 属于该标的，不能用 unwrapItems 展开）。
+func decodeSeries(data json.RawMessage, out interface{}) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	if trimmed[0] == '{' {
+		trimmed = append(append([]byte{'['}, trimmed...), ']')
+	}
+	return json.Unmarshal(trimmed, out)
+}
+
+// MarketPhase 是市场当前所处的交易阶段。
+type MarketPhase string
+
+const (
+	MarketPhaseUnknown     MarketPhase = ""
+	MarketPhaseNotYetOpen  MarketPhase = "NOT_YET_OPEN"
+	MarketPhasePreHours    MarketPhase = "PRE_HOURS_TRADING"
+	MarketPhaseTrading     MarketPhase = "TRADING"
+	MarketPhaseMidClose    MarketPhase = "MIDDLE_CLOSE"
+	MarketPhasePostHours   MarketPhase = "POST_HOURS_TRADING"
+	MarketPhaseClosed      MarketPhase = "MARKET_CLOSED"
+	MarketPhaseEarlyClosed MarketPhase = "EARLY_CLOSED"
+)
+
+// marketPhaseAliases 的 key 为去掉空格、连字符与下划线后的大写状态描述。
+var marketPhaseAliases = map[string]MarketPhase{
+	"NOTYETOPEN":        MarketPhaseNotYetOpen,
+	"NOTYETOPENED":      MarketPhaseNotYetOpen,
+	"PREHOURSTRADING":   MarketPhasePreHours,
+	"PREMARKETTRADING":  MarketPhasePreHours,
+	"TRADING":           MarketPhaseTrading,
+	"MIDDLECLOSE":       MarketPhaseMidClose,
+	"MIDDAYBREAK":       MarketPhaseMidClose,
+	"LUNCHBREAK":        MarketPhaseMidClose,
+	"POSTHOURSTRADING":  MarketPhasePostHours,
+	"POSTMARKETTRADING": MarketPhasePostHours,
+	"MARKETCLOSED":      MarketPhaseClosed,
+	"CLOSED":            MarketPhaseClosed,
+	"CLOSING":           MarketPhaseClosed,
+	"EARLYCLOSED":       MarketPhaseEarlyClosed,
+}
+
+// ParseMarketPhase 将网关状态（如 TRADING、Not Yet Opened）映射为 MarketPhase。
+func ParseMarketPhase(s string) MarketPhase {
+	key := strings.ToUpper(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(s))
+	return marketPhaseAliases[key]
+}
+
+// IsOpen 表示当前是否可以交易（含盘前盘后）。
+func (p MarketPhase) IsOpen() bool {
+	return p == MarketPhaseTrading || p == MarketPhasePreHours || p == MarketPhasePostHours
+}
+
+// MarketStatus 是单个市场的交易状态。
+type MarketStatus struct {
+	Market       string
+	Phase        MarketPhase
+	StatusText   string    // 网关返回的本地化状态描述。
+	NextOpenTime time.Time // 下一次开盘时间，网关未返回时为零值。
+	Raw          json.RawMessage
+}
+
+type marketStatusItem struct {
+	Market        string `json:"market"`
+	TradingStatus string `json:"tradingStatus"`
+	Status        string `json:"status"`
+	MarketStatus  string `json:"marketStatus"`
+	OpenTime      string `json:"openTime"`
+}
+
+type MarketStatusResult struct {
+	Response APIResponse
+	Markets  []MarketStatus
+}
+
+// GetMarketStatus 查询市场交易状态，market 为 MarketAll 时返回全部市场。
+func (q *QuoteClient) GetMarketStatus(ctx context.Context, market string) (*MarketStatusResult, error) {
+	if market == "" {
+		market = MarketAll
+	}
+	biz := q.biz()
+	biz["market"] = market
+	resp, err := q.client.call(ctx, "market_state", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("market_state", resp, 0, ""); err != nil {
+		return &MarketStatusResult{Response: resp}, err
+	}
+	items, err := unwrapItems(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode market_state data: %w", err)
+	}
+	result := &MarketStatusResult{Response: resp}
+	now := time.Now()
+	for _, raw := range items {
+		var item marketStatusItem
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("decode market status item: %w", err)
+		}
+		phase := item.TradingStatus
+		if phase == "" {
+			phase = item.Status
+		}
+		status := MarketStatus{
+			Market:     item.Market,
+			Phase:      ParseMarketPhase(phase),
+			StatusText: item.MarketStatus,
+			Raw:        raw,
+		}
+		if item.OpenTime != "" {
+			status.NextOpenTime = parseOpenTime(item.OpenTime, MarketLocation(item.Market), now)
+		}
+		result.Markets = append(result.Markets, status)
+	}
+	return result, nil
+}
+
+// parseOpenTime 解析形如 "11-19 09:30:00 EST" 的开盘时间，网关不返回年份，取距离 now 最近的年份。
+func parseOpenTime(s string, loc *time.Location, now time.Time) time.Time {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return time.Time{}
+	}
+	t, err := time.ParseInLocation("01-02 15:04:05", fields[0]+" "+fields[1], loc)
+	if err != nil {
+		return time.Time{}
+	}
+	best := time.Time{}
+	for _, year := range []int{now.Year() - 1, now.Year(), now.Year() + 1} {
+		candidate := time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+		if best.IsZero() || absDuration(candidate.Sub(now)) < absDuration(best.Sub(now)) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// 交易日类型。
+const (
+	TradingDayNormal     = "TRADING"
+	TradingDayEarlyClose = "EARLY_CLOSE"
+)
+
+// TradingDay 是交易日历中的一天，Date 为市场当地时区的零点。
+type TradingDay struct {
+	Date time.Time
+	Type string
+}
+
+type TradingCalendarResult struct {
+	Response APIResponse
+	Days     []TradingDay
+}
+
+// GetTradingCalendar 查询 [begin, end] 区间内的交易日，日期按市场当地日期传给网关。
+func (q *QuoteClient) GetTradingCalendar(ctx context.Context, market string, begin, end time.Time) (*TradingCalendarResult, error) {
+	if market == "" || market == MarketAll {
+		return nil, fmt.Errorf("%w: trading_calendar requires a single market", ErrInvalidParameter)
+	}
+	if !begin.IsZero() && !end.IsZero() && end.Before(begin) {
+		return nil, fmt.Errorf("%w: end date before begin date", ErrInvalidParameter)
+	}
+	loc := MarketLocation(market)
+	biz := q.biz()
+	biz["market"] = market
+	if !begin.IsZero() {
+		biz["begin_date"] = begin.In(loc).Format("2006-01-02")
+	}
+	if !end.IsZero() {
+		biz["end_date"] = end.In(loc).Format("2006-01-02")
+	}
+	resp, err := q.client.call(ctx, "trading_calendar", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("trading_calendar", resp, 0, ""); err != nil {
+		return &TradingCalendarResult{Response: resp}, err
+	}
+	items, err := unwrapItems(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode trading_calendar data: %w", err)
+	}
+	result := &TradingCalendarResult{Response: resp}
+	for _, raw := range items {
+		var item struct {
+			Date string `json:"date"`
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("decode trading day: %w", err)
+		}
+		date, err := time.ParseInLocation("2006-01-02", item.Date, loc)
+		if err != nil {
+			return nil, fmt.Errorf("parse trading day %q: %w", item.Date, err)
+		}
+		result.Days = append(result.Days, TradingDay{Date: date, Type: item.Type})
+	}
+	return result, nil
+}
@@ -0,0 +1,258 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// maxBarSymbols 是 kline 单次请求允许的最大标的数。
+const maxBarSymbols = 50
+
+// BarPeriod 是 K 线周期。
+type BarPeriod string
+
+const (
+	BarPeriod1Min  BarPeriod = "1min"
+	BarPeriod3Min  BarPeriod = "3min"
+	BarPeriod5Min  BarPeriod = "5min"
+	BarPeriod10Min BarPeriod = "10min"
+	BarPeriod15Min BarPeriod = "15min"
+	BarPeriod30Min BarPeriod = "30min"
+	BarPeriod45Min BarPeriod = "45min"
+	BarPeriod60Min BarPeriod = "60min"
+	BarPeriod2Hour BarPeriod = "2hour"
+	BarPeriod3Hour BarPeriod = "3hour"
+	BarPeriod4Hour BarPeriod = "4hour"
+	BarPeriod6Hour BarPeriod = "6hour"
+	BarPeriodDay   BarPeriod = "day"
+	BarPeriodWeek  BarPeriod = "week"
+	BarPeriodMonth BarPeriod = "month"
+	BarPeriodYear  BarPeriod = "year"
+)
+
+var barPeriods = map[BarPeriod]bool{
+	BarPeriod1Min: true, BarPeriod3Min: true, BarPeriod5Min: true, BarPeriod10Min: true,
+	BarPeriod15Min: true, BarPeriod30Min: true, BarPeriod45Min: true, BarPeriod60Min: true,
+	BarPeriod2Hour: true, BarPeriod3Hour: true, BarPeriod4Hour: true, BarPeriod6Hour: true,
+	BarPeriodDay: true, BarPeriodWeek: true, BarPeriodMonth: true, BarPeriodYear: true,
+}
+
+// QuoteRight 是 K 线复权方式。网关只提供前复权（br）与不复权（nr），没有后复权模式，需要时请基于不复权数据自行计算。
+type QuoteRight string
+
+const (
+	RightForward QuoteRight = "br" // 前复权。
+	RightNone    QuoteRight = "nr" // 不复权。
+)
+
+// BarsRequest 是 kline 的查询条件。PageToken 仅在单个标的时生效。
+type BarsRequest struct {
+	Symbols   []string
+	Period    BarPeriod
+	BeginTime time.Time
+	EndTime   time.Time
+	Limit     int
+	Right     QuoteRight
+	PageToken string
+	Language  string
+}
+
+func (r BarsRequest) toBiz(cfg Config) map[string]interface{} {
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if len(r.Symbols) > 0 {
+		biz["symbols"] = r.Symbols
+	}
+	if r.Period != "" {
+		biz["period"] = string(r.Period)
+	}
+	if !r.BeginTime.IsZero() {
+		biz["begin_time"] = r.BeginTime.UnixMilli()
+	}
+	if !r.EndTime.IsZero() {
+		biz["end_time"] = r.EndTime.UnixMilli()
+	}
+	if r.Limit > 0 {
+		biz["limit"] = r.Limit
+	}
+	if r.Right != "" {
+		biz["right"] = string(r.Right)
+	}
+	if r.PageToken != "" {
+		biz["page_token"] = r.PageToken
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+func (r BarsRequest) validate() error {
+	if len(r.Symbols) == 0 {
+		return fmt.Errorf("%w: kline requires symbols", ErrInvalidParameter)
+	}
+	if len(r.Symbols) > maxBarSymbols {
+		return fmt.Errorf("%w: kline accepts at most %d symbols, got %d", ErrInvalidParameter, maxBarSymbols, len(r.Symbols))
+	}
+	if !barPeriods[r.Period] {
+		return fmt.Errorf("%w: unknown bar period %q", ErrInvalidParameter, string(r.Period))
+	}
+	if r.Right != "" && r.Right != RightForward && r.Right != RightNone {
+		return fmt.Errorf("%w: unknown quote right %q", ErrInvalidParameter, string(r.Right))
+	}
+	if r.PageToken != "" && len(r.Symbols) != 1 {
+		return fmt.Errorf("%w: page token requires a single symbol", ErrInvalidParameter)
+	}
+	if !r.BeginTime.IsZero() && !r.EndTime.IsZero() && r.EndTime.Before(r.BeginTime) {
+		return fmt.Errorf("%w: end time before begin time", ErrInvalidParameter)
+	}
+	return nil
+}
+
+// Bar 是一根 K 线。
+type Bar struct {
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume int64
+	Amount float64
+}
+
+func (b *Bar) UnmarshalJSON(data []byte) error {
+	var item struct {
+		Time   int64         `json:"time"`
+		Open   FloatOrString `json:"open"`
+		High   FloatOrString `json:"high"`
+		Low    FloatOrString `json:"low"`
+		Close  FloatOrString `json:"close"`
+		Volume FloatOrString `json:"volume"`
+		Amount FloatOrString `json:"amount"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	*b = Bar{
+		Time:   timeFromMillis(item.Time),
+		Open:   float64(item.Open),
+		High:   float64(item.High),
+		Low:    float64(item.Low),
+		Close:  float64(item.Close),
+		Volume: int64(item.Volume),
+		Amount: float64(item.Amount),
+	}
+	return nil
+}
+
+// BarSeries 是单个标的的一段 K 线，NextPageToken 非空时表示还有更多数据。
+type BarSeries struct {
+	Symbol        string    `json:"symbol"`
+	Period        BarPeriod `json:"period"`
+	NextPageToken string    `json:"nextPageToken,omitempty"`
+	Bars          []Bar     `json:"items"`
+}
+
+type BarsResult struct {
+	Response APIResponse // 多次请求时为最后一次的响应。
+	Series   []BarSeries
+}
+
+// SeriesFor 返回指定标的的 K 线序列，不存在时返回 nil。
+func (r BarsResult) SeriesFor(symbol string) *BarSeries {
+	for i := range r.Series {
+		if r.Series[i].Symbol == symbol {
+			return &r.Series[i]
+		}
+	}
+	return nil
+}
+
+// GetBars 查询 K 线，单次最多 50 个标的，分页请使用返回的 NextPageToken 或 GetBarsRange。
+func (q *QuoteClient) GetBars(ctx context.Context, req BarsRequest) (*BarsResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	return q.queryBars(ctx, "kline", req)
+}
+
+// GetBarsRange 拉取 [BeginTime, EndTime] 区间内的全部 K 线：page_token 只支持单个标的，因此逐个标的请求
+// 并沿 NextPageToken 翻页直至取完。返回的每个序列按时间升序排列，req.PageToken 会被忽略。
+// 后续页缺少该标的或 token 未推进时返回错误，已取得的结果一并返回，未取完的序列保留 NextPageToken。
+func (q *QuoteClient) GetBarsRange(ctx context.Context, req BarsRequest) (*BarsResult, error) {
+	if len(req.Symbols) == 0 {
+		return nil, fmt.Errorf("%w: kline requires symbols", ErrInvalidParameter)
+	}
+	result := &BarsResult{}
+	for _, symbol := range req.Symbols {
+		pageReq := req
+		pageReq.Symbols = []string{symbol}
+		pageReq.PageToken = ""
+		if err := pageReq.validate(); err != nil {
+			return nil, err
+		}
+		page, err := q.queryBars(ctx, "kline", pageReq)
+		if err != nil {
+			return page, err
+		}
+		result.Response = page.Response
+		first := page.SeriesFor(symbol)
+		if first == nil {
+			continue
+		}
+		series := *first
+		for series.NextPageToken != "" {
+			pageReq.PageToken = series.NextPageToken
+			next, err := q.queryBars(ctx, "kline", pageReq)
+			if err != nil {
+				return next, err
+			}
+			result.Response = next.Response
+			more := next.SeriesFor(symbol)
+			if more == nil {
+				err = fmt.Errorf("kline page for %s at token %q missing from response", symbol, series.NextPageToken)
+			} else if more.NextPageToken == series.NextPageToken {
+				err = fmt.Errorf("kline pagination for %s stalled at token %q", symbol, series.NextPageToken)
+			}
+			if err != nil {
+				sortBars(series.Bars)
+				result.Series = append(result.Series, series)
+				return result, err
+			}
+			series.Bars = append(series.Bars, more.Bars...)
+			series.NextPageToken = more.NextPageToken
+		}
+		sortBars(series.Bars)
+		result.Series = append(result.Series, series)
+	}
+	return result, nil
+}
+
+func sortBars(bars []Bar) {
+	sort.SliceStable(bars, func(i, j int) bool {
+		return bars[i].Time.Before(bars[j].Time)
+	})
+}
+
+func (q *QuoteClient) queryBars(ctx context.Context, method string, req BarsRequest) (*BarsResult, error) {
+	biz := req.toBiz(q.client.cfg)
+	resp, err := q.client.call(ctx, method, biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(method, resp, 0, ""); err != nil {
+		return &BarsResult{Response: resp}, err
+	}
+	result := &BarsResult{Response: resp}
+	if err := decodeSeries(resp.Data, &result.Series); err != nil {
+		return nil, fmt.Errorf("decode %s data: %w", method, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package tigeropen
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// barPages 以单标的分页返回 K 线：pages[symbol] 为各页的 bar 时间（毫秒），token 为下一页下标。
+func barPages(t *testing.T, pages map[string][][]int64, dropAfterFirst bool) func(map[string]interface{}) interface{} {
+	return func(biz map[string]interface{}) interface{} {
+		symbols, _ := biz["symbols"].([]interface{})
+		if len(symbols) != 1 {
+			t.Errorf("kline request with %d symbols, want 1", len(symbols))
+			return []interface{}{}
+		}
+		symbol, _ := symbols[0].(string)
+		token, _ := biz["page_token"].(string)
+		index := 0
+		if token != "" {
+			if dropAfterFirst {
+				return []interface{}{}
+			}
+			index = int(token[0] - '0')
+		}
+		var items []map[string]interface{}
+		for _, ms := range pages[symbol][index] {
+			items = append(items, map[string]interface{}{"time": ms, "close": 1})
+		}
+		series := map[string]interface{}{"symbol": symbol, "period": "day", "items": items}
+		if index+1 < len(pages[symbol]) {
+			series["nextPageToken"] = string(rune('0' + index + 1))
+		}
+		return []interface{}{series}
+	}
+}
+
+func TestGetBarsRangePagesEachSymbol(t *testing.T) {
+	pages := map[string][][]int64{
+		"AAPL": {{3000, 4000}, {1000, 2000}},
+		"TSLA": {{5000}},
+	}
+	requests := 0
+	client := pagedGateway(t, &requests, barPages(t, pages, false))
+	result, err := client.Quote().GetBarsRange(context.Background(), BarsRequest{Symbols: []string{"AAPL", "TSLA"}, Period: BarPeriodDay})
+	if err != nil {
+		t.Fatalf("GetBarsRange: %v", err)
+	}
+	if requests != 3 {
+		t.Fatalf("requests = %d, want 3", requests)
+	}
+	aapl := result.SeriesFor("AAPL")
+	if aapl == nil || len(aapl.Bars) != 4 || aapl.NextPageToken != "" {
+		t.Fatalf("AAPL series = %+v", aapl)
+	}
+	for i := 1; i < len(aapl.Bars); i++ {
+		if !aapl.Bars[i-1].Time.Before(aapl.Bars[i].Time) {
+			t.Fatalf("AAPL bars not ascending: %+v", aapl.Bars)
+		}
+	}
+	if tsla := result.SeriesFor("TSLA"); tsla == nil || len(tsla.Bars) != 1 {
+		t.Fatalf("TSLA series = %+v", tsla)
+	}
+}
+
+func TestGetBarsRangeMissingFollowUpPage(t *testing.T) {
+	pages := map[string][][]int64{"AAPL": {{1000}, {2000}}}
+	requests := 0
+	client := pagedGateway(t, &requests, barPages(t, pages, true))
+	result, err := client.Quote().GetBarsRange(context.Background(), BarsRequest{Symbols: []string{"AAPL"}, Period: BarPeriodDay})
+	if err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Fatalf("GetBarsRange err = %v, want missing page error", err)
+	}
+	aapl := result.SeriesFor("AAPL")
+	if aapl == nil || len(aapl.Bars) != 1 || aapl.NextPageToken != "1" {
+		t.Fatalf("partial AAPL series = %+v", aapl)
+	}
+}
@@ -0,0 +1,161 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxBriefSymbols 是 quote_real_time / quote_delay 单次请求允许的最大标的数。
+const maxBriefSymbols = 50
+
+// HourTradingBrief 是盘前或盘后的行情快照。
+type HourTradingBrief struct {
+	Tag         string // 盘前、盘后等标签。
+	LatestPrice float64
+	PreClose    float64
+	Volume      int64
+	LatestTime  time.Time
+}
+
+// Brief 是股票行情快照，延迟行情没有买卖盘与盘前盘后数据。
+type Brief struct {
+	Symbol      string
+	LatestPrice float64
+	PreClose    float64
+	Open        float64
+	High        float64
+	Low         float64
+	Close       float64 // 仅延迟行情返回。
+	Volume      int64
+	BidPrice    float64
+	BidSize     int64
+	AskPrice    float64
+	AskSize     int64
+	Status      string // NORMAL、HALTED、DELIST 等。
+	Halted      bool
+	LatestTime  time.Time
+	HourTrading *HourTradingBrief
+	Raw         json.RawMessage
+}
+
+type briefItem struct {
+	Symbol      string        `json:"symbol"`
+	LatestPrice FloatOrString `json:"latestPrice"`
+	PreClose    FloatOrString `json:"preClose"`
+	Open        FloatOrString `json:"open"`
+	High        FloatOrString `json:"high"`
+	Low         FloatOrString `json:"low"`
+	Close       FloatOrString `json:"close"`
+	Volume      FloatOrString `json:"volume"`
+	BidPrice    FloatOrString `json:"bidPrice"`
+	BidSize     FloatOrString `json:"bidSize"`
+	AskPrice    FloatOrString `json:"askPrice"`
+	AskSize     FloatOrString `json:"askSize"`
+	Status      string        `json:"status"`
+	Halted      FloatOrString `json:"halted"`
+	LatestTime  int64         `json:"latestTime"`
+	Time        int64         `json:"time"`
+	HourTrading *struct {
+		Tag         string        `json:"tag"`
+		LatestPrice FloatOrString `json:"latestPrice"`
+		PreClose    FloatOrString `json:"preClose"`
+		Volume      FloatOrString `json:"volume"`
+		LatestTime  int64         `json:"timestamp"`
+	} `json:"hourTrading"`
+}
+
+func (b *Brief) UnmarshalJSON(data []byte) error {
+	var item briefItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	latest := item.LatestTime
+	if latest == 0 {
+		latest = item.Time
+	}
+	*b = Brief{
+		Symbol:      item.Symbol,
+		LatestPrice: float64(item.LatestPrice),
+		PreClose:    float64(item.PreClose),
+		Open:        float64(item.Open),
+		High:        float64(item.High),
+		Low:         float64(item.Low),
+		Close:       float64(item.Close),
+		Volume:      int64(item.Volume),
+		BidPrice:    float64(item.BidPrice),
+		BidSize:     int64(item.BidSize),
+		AskPrice:    float64(item.AskPrice),
+		AskSize:     int64(item.AskSize),
+		Status:      item.Status,
+		// 延迟行情以 halted=3.0 表示停牌。
+		Halted:     strings.EqualFold(item.Status, "HALTED") || item.Halted == 3,
+		LatestTime: timeFromMillis(latest),
+	}
+	if h := item.HourTrading; h != nil {
+		b.HourTrading = &HourTradingBrief{
+			Tag:         h.Tag,
+			LatestPrice: float64(h.LatestPrice),
+			PreClose:    float64(h.PreClose),
+			Volume:      int64(h.Volume),
+			LatestTime:  timeFromMillis(h.LatestTime),
+		}
+	}
+	return nil
+}
+
+type BriefsResult struct {
+	Response APIResponse // 多批次请求时为最后一批的响应。
+	Briefs   []Brief
+}
+
+// GetBriefs 查询实时行情快照，includeHourTrading 为 true 时附带盘前盘后数据，超过单次上限的标的会自动分批请求。
+func (q *QuoteClient) GetBriefs(ctx context.Context, symbols []string, includeHourTrading bool) (*BriefsResult, error) {
+	return q.queryBriefs(ctx, "quote_real_time", symbols, func(biz map[string]interface{}) {
+		if includeHourTrading {
+			biz["include_hour_trading"] = true
+		}
+	})
+}
+
+// GetDelayBriefs 查询延迟行情快照，超过单次上限的标的会自动分批请求。
+func (q *QuoteClient) GetDelayBriefs(ctx context.Context, symbols []string) (*BriefsResult, error) {
+	return q.queryBriefs(ctx, "quote_delay", symbols, nil)
+}
+
+func (q *QuoteClient) queryBriefs(ctx context.Context, method string, symbols []string, extra func(map[string]interface{})) (*BriefsResult, error) {
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("%w: %s requires symbols", ErrInvalidParameter, method)
+	}
+	result := &BriefsResult{}
+	for _, batch := range batchStrings(symbols, maxBriefSymbols) {
+		biz := q.biz()
+		biz["symbols"] = batch
+		if extra != nil {
+			extra(biz)
+		}
+		resp, err := q.client.call(ctx, method, biz)
+		if err != nil {
+			return nil, err
+		}
+		result.Response = resp
+		if err := checkResponse(method, resp, 0, ""); err != nil {
+			return result, err
+		}
+		items, err := unwrapItems(resp.Data)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s data: %w", method, err)
+		}
+		for _, raw := range items {
+			var item Brief
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return nil, fmt.Errorf("decode brief item: %w", err)
+			}
+			item.Raw = raw
+			result.Briefs = append(result.Briefs, item)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,152 @@
+package tigeropen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// maxDepthSymbols 是 quote_depth 单次请求允许的最大标的数。
+const maxDepthSymbols = 50
+
+// DepthLevel 是盘口的一档。
+type DepthLevel struct {
+	Price float64
+	Size  int64
+	Count int // 该价位的订单数，部分市场不返回。
+}
+
+// DepthLevels 兼容三种盘口格式：对象数组 [{"price","volume","count"}]、
+// 二维数组 [[price, volume, count]] 以及并列数组 {"price": [], "volume": [], "count": []}。
+type DepthLevels []DepthLevel
+
+func (d *DepthLevels) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		*d = nil
+		return nil
+	}
+	if trimmed[0] == '{' {
+		var columns struct {
+			Price  []FloatOrString `json:"price"`
+			Volume []FloatOrString `json:"volume"`
+			Count  []FloatOrString `json:"count"`
+		}
+		if err := json.Unmarshal(trimmed, &columns); err != nil {
+			return err
+		}
+		levels := make(DepthLevels, len(columns.Price))
+		for i := range columns.Price {
+			levels[i].Price = float64(columns.Price[i])
+			if i < len(columns.Volume) {
+				levels[i].Size = int64(columns.Volume[i])
+			}
+			if i < len(columns.Count) {
+				levels[i].Count = int(columns.Count[i])
+			}
+		}
+		*d = levels
+		return nil
+	}
+
+	var rows []json.RawMessage
+	if err := json.Unmarshal(trimmed, &rows); err != nil {
+		return err
+	}
+	levels := make(DepthLevels, 0, len(rows))
+	for _, row := range rows {
+		row = bytes.TrimSpace(row)
+		var level DepthLevel
+		if len(row) > 0 && row[0] == '[' {
+			var values []FloatOrString
+			if err := json.Unmarshal(row, &values); err != nil {
+				return err
+			}
+			if len(values) > 0 {
+				level.Price = float64(values[0])
+			}
+			if len(values) > 1 {
+				level.Size = int64(values[1])
+			}
+			if len(values) > 2 {
+				level.Count = int(values[2])
+			}
+		} else {
+			var item struct {
+				Price  FloatOrString `json:"price"`
+				Volume FloatOrString `json:"volume"`
+				Count  FloatOrString `json:"count"`
+			}
+			if err := json.Unmarshal(row, &item); err != nil {
+				return err
+			}
+			level = DepthLevel{Price: float64(item.Price), Size: int64(item.Volume), Count: int(item.Count)}
+		}
+		levels = append(levels, level)
+	}
+	*d = levels
+	return nil
+}
+
+// Depth 是单个标的的盘口快照，Asks 按价格升序、Bids 按价格降序。
+type Depth struct {
+	Symbol string          `json:"symbol"`
+	Asks   DepthLevels     `json:"asks"`
+	Bids   DepthLevels     `json:"bids"`
+	Raw    json.RawMessage `json:"-"`
+}
+
+type DepthResult struct {
+	Response APIResponse // 多批次请求时为最后一批的响应。
+	Depths   []Depth
+}
+
+// DepthFor 返回指定标的的盘口，不存在时返回 nil。
+func (r DepthResult) DepthFor(symbol string) *Depth {
+	for i := range r.Depths {
+		if r.Depths[i].Symbol == symbol {
+			return &r.Depths[i]
+		}
+	}
+	return nil
+}
+
+// GetDepth 查询美股或港股的盘口深度，超过单次上限的标的会自动分批请求。
+func (q *QuoteClient) GetDepth(ctx context.Context, symbols []string, market string) (*DepthResult, error) {
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("%w: quote_depth requires symbols", ErrInvalidParameter)
+	}
+	market = strings.ToUpper(market)
+	if market != MarketUS && market != MarketHK {
+		return nil, fmt.Errorf("%w: quote_depth supports US and HK markets, got %q", ErrInvalidParameter, market)
+	}
+	result := &DepthResult{}
+	for _, batch := range batchStrings(symbols, maxDepthSymbols) {
+		biz := q.biz()
+		biz["symbols"] = batch
+		biz["market"] = market
+		resp, err := q.client.call(ctx, "quote_depth", biz)
+		if err != nil {
+			return nil, err
+		}
+		result.Response = resp
+		if err := checkResponse("quote_depth", resp, 0, ""); err != nil {
+			return result, err
+		}
+		var raws []json.RawMessage
+		if err := decodeSeries(resp.Data, &raws); err != nil {
+			return nil, fmt.Errorf("decode quote_depth data: %w", err)
+		}
+		for _, raw := range raws {
+			var item Depth
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return nil, fmt.Errorf("decode depth item: %w", err)
+			}
+			item.Raw = raw
+			result.Depths = append(result.Depths, item)
+		}
+	}
+	return result, nil
+}
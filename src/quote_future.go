@@ -0,0 +1,611 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// maxFutureContracts 是 future_real_time_quote / future_kline 单次请求允许的最大合约数。
+const maxFutureContracts = 50
+
+// FutureExchange 是期货交易所。
+type FutureExchange struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	Zone string `json:"zoneId"` // 交易所时区，如 America/Chicago。
+}
+
+// Location 返回交易所所在时区，无法识别时返回 UTC。
+func (e FutureExchange) Location() *time.Location {
+	if e.Zone == "" {
+		return time.UTC
+	}
+	if loc, err := time.LoadLocation(e.Zone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+type FutureExchangesResult struct {
+	Response  APIResponse
+	Exchanges []FutureExchange
+}
+
+// GetFutureExchanges 查询期货交易所列表。
+func (q *QuoteClient) GetFutureExchanges(ctx context.Context) (*FutureExchangesResult, error) {
+	biz := q.biz()
+	biz["sec_type"] = "FUT"
+	resp, err := q.client.call(ctx, "future_exchange", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("future_exchange", resp, 0, ""); err != nil {
+		return &FutureExchangesResult{Response: resp}, err
+	}
+	items, err := unwrapItems(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode future_exchange data: %w", err)
+	}
+	result := &FutureExchangesResult{Response: resp}
+	for _, raw := range items {
+		var item FutureExchange
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("decode future exchange: %w", err)
+		}
+		result.Exchanges = append(result.Exchanges, item)
+	}
+	return result, nil
+}
+
+// FutureContract 是期货合约信息。连续合约的 ContractCode 形如 CLmain，LastTradingDate 为空。
+type FutureContract struct {
+	ContractCode         string
+	Type                 string // 品种代码，如 CL、ES。
+	Name                 string
+	Exchange             string
+	ExchangeCode         string
+	Currency             string
+	ContractMonth        string
+	Multiplier           float64
+	MinTick              float64
+	LastTradingDate      string // YYYYMMDD。
+	FirstNoticeDate      string // YYYYMMDD，无实物交割的品种为空。
+	LastBiddingCloseTime time.Time
+	Tradeable            bool
+	Continuous           bool
+	Raw                  json.RawMessage
+}
+
+func (c *FutureContract) UnmarshalJSON(data []byte) error {
+	var item struct {
+		ContractCode         string        `json:"contractCode"`
+		Type                 string        `json:"type"`
+		Name                 string        `json:"name"`
+		Exchange             string        `json:"exchange"`
+		ExchangeCode         string        `json:"exchangeCode"`
+		Currency             string        `json:"currency"`
+		ContractMonth        string        `json:"contractMonth"`
+		Multiplier           FloatOrString `json:"multiplier"`
+		MinTick              FloatOrString `json:"minTick"`
+		LastTradingDate      string        `json:"lastTradingDate"`
+		FirstNoticeDate      string        `json:"firstNoticeDate"`
+		LastBiddingCloseTime int64         `json:"lastBiddingCloseTime"`
+		Trade                bool          `json:"trade"`
+		Continuous           bool          `json:"continuous"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	*c = FutureContract{
+		ContractCode:         item.ContractCode,
+		Type:                 item.Type,
+		Name:                 item.Name,
+		Exchange:             item.Exchange,
+		ExchangeCode:         item.ExchangeCode,
+		Currency:             item.Currency,
+		ContractMonth:        item.ContractMonth,
+		Multiplier:           float64(item.Multiplier),
+		MinTick:              float64(item.MinTick),
+		LastTradingDate:      item.LastTradingDate,
+		FirstNoticeDate:      item.FirstNoticeDate,
+		LastBiddingCloseTime: timeFromMillis(item.LastBiddingCloseTime),
+		Tradeable:            item.Trade,
+		Continuous:           item.Continuous,
+		Raw:                  append(json.RawMessage(nil), data...),
+	}
+	return nil
+}
+
+// ToContract 将期货合约转为下单使用的 Contract。
+func (c FutureContract) ToContract() Contract {
+	contract := Contract{
+		Symbol:   c.ContractCode,
+		Currency: c.Currency,
+		SecType:  "FUT",
+		Exchange: c.Exchange,
+		Expiry:   c.LastTradingDate,
+	}
+	if c.Multiplier != 0 {
+		contract.Multiplier = strconv.FormatFloat(c.Multiplier, 'f', -1, 64)
+	}
+	return contract
+}
+
+type FutureContractsResult struct {
+	Response  APIResponse
+	Contracts []FutureContract
+}
+
+// GetFutureContracts 查询交易所下可交易的期货合约，exchangeCode 取自 GetFutureExchanges。
+func (q *QuoteClient) GetFutureContracts(ctx context.Context, exchangeCode string) (*FutureContractsResult, error) {
+	if exchangeCode == "" {
+		return nil, fmt.Errorf("%w: future_contract_by_exchange_code requires exchange code", ErrInvalidParameter)
+	}
+	return q.queryFutureContracts(ctx, "future_contract_by_exchange_code", "exchange_code", exchangeCode)
+}
+
+// GetFutureContract 按合约代码（如 CL2412）查询期货合约。
+func (q *QuoteClient) GetFutureContract(ctx context.Context, contractCode string) (*FutureContractsResult, error) {
+	if contractCode == "" {
+		return nil, fmt.Errorf("%w: future_contract_by_contract_code requires contract code", ErrInvalidParameter)
+	}
+	return q.queryFutureContracts(ctx, "future_contract_by_contract_code", "contract_code", contractCode)
+}
+
+// GetFutureCurrentContract 查询品种（如 CL）当前的主力合约。
+func (q *QuoteClient) GetFutureCurrentContract(ctx context.Context, futureType string) (*FutureContractsResult, error) {
+	if futureType == "" {
+		return nil, fmt.Errorf("%w: future_current_contract requires future type", ErrInvalidParameter)
+	}
+	return q.queryFutureContracts(ctx, "future_current_contract", "type", futureType)
+}
+
+// GetFutureContinuousContracts 查询品种（如 CL）的连续合约。
+func (q *QuoteClient) GetFutureContinuousContracts(ctx context.Context, futureType string) (*FutureContractsResult, error) {
+	if futureType == "" {
+		return nil, fmt.Errorf("%w: future_continuous_contracts requires future type", ErrInvalidParameter)
+	}
+	return q.queryFutureContracts(ctx, "future_continuous_contracts", "type", futureType)
+}
+
+func (q *QuoteClient) queryFutureContracts(ctx context.Context, method, key, value string) (*FutureContractsResult, error) {
+	biz := q.biz()
+	biz[key] = value
+	resp, err := q.client.call(ctx, method, biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(method, resp, 0, ""); err != nil {
+		return &FutureContractsResult{Response: resp}, err
+	}
+	items, err := unwrapItems(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s data: %w", method, err)
+	}
+	result := &FutureContractsResult{Response: resp}
+	for _, raw := range items {
+		var item FutureContract
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("decode future contract: %w", err)
+		}
+		result.Contracts = append(result.Contracts, item)
+	}
+	return result, nil
+}
+
+// FutureSession 是一段期货交易时段。
+type FutureSession struct {
+	Begin time.Time
+	End   time.Time
+}
+
+// Contains 表示 t 是否落在 [Begin, End) 内。
+func (s FutureSession) Contains(t time.Time) bool {
+	return !t.Before(s.Begin) && t.Before(s.End)
+}
+
+// FutureTradingTimes 是合约在某个交易日的交易时段，Bidding 为集合竞价时段。
+type FutureTradingTimes struct {
+	ContractCode string
+	Zone         string
+	Trading      []FutureSession
+	Bidding      []FutureSession
+}
+
+// IsTrading 表示 t 是否处于连续交易时段。
+func (t FutureTradingTimes) IsTrading(at time.Time) bool {
+	for _, s := range t.Trading {
+		if s.Contains(at) {
+			return true
+		}
+	}
+	return false
+}
+
+type FutureTradingTimesResult struct {
+	Response APIResponse
+	Times    FutureTradingTimes
+}
+
+// GetFutureTradingTimes 查询合约在 tradingDate 所在交易日的交易时段，可用于换月与收盘前平仓判断。
+func (q *QuoteClient) GetFutureTradingTimes(ctx context.Context, contractCode string, tradingDate time.Time) (*FutureTradingTimesResult, error) {
+	if contractCode == "" {
+		return nil, fmt.Errorf("%w: future_trading_date requires contract code", ErrInvalidParameter)
+	}
+	if tradingDate.IsZero() {
+		tradingDate = time.Now()
+	}
+	biz := q.biz()
+	biz["contract_code"] = contractCode
+	biz["trading_date"] = tradingDate.UnixMilli()
+	resp, err := q.client.call(ctx, "future_trading_date", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("future_trading_date", resp, 0, ""); err != nil {
+		return &FutureTradingTimesResult{Response: resp}, err
+	}
+	type session struct {
+		Start int64 `json:"start"`
+		End   int64 `json:"end"`
+	}
+	var item struct {
+		TradingTimes []session `json:"tradingTimes"`
+		BiddingTimes []session `json:"biddingTimes"`
+		TimeSection  string    `json:"timeSection"`
+	}
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &item); err != nil {
+			return nil, fmt.Errorf("decode future_trading_date data: %w", err)
+		}
+	}
+	convert := func(in []session) []FutureSession {
+		var out []FutureSession
+		for _, s := range in {
+			out = append(out, FutureSession{Begin: timeFromMillis(s.Start), End: timeFromMillis(s.End)})
+		}
+		return out
+	}
+	return &FutureTradingTimesResult{
+		Response: resp,
+		Times: FutureTradingTimes{
+			ContractCode: contractCode,
+			Zone:         item.TimeSection,
+			Trading:      convert(item.TradingTimes),
+			Bidding:      convert(item.BiddingTimes),
+		},
+	}, nil
+}
+
+// FutureBrief 是期货行情快照。
+type FutureBrief struct {
+	ContractCode       string
+	LatestPrice        float64
+	LatestSize         int64
+	LatestTime         time.Time
+	BidPrice           float64
+	BidSize            int64
+	AskPrice           float64
+	AskSize            int64
+	Open               float64
+	High               float64
+	Low                float64
+	Settlement         float64 // 昨结算价。
+	LimitUp            float64
+	LimitDown          float64
+	Volume             int64
+	OpenInterest       int64
+	OpenInterestChange int64
+	Raw                json.RawMessage
+}
+
+func (b *FutureBrief) UnmarshalJSON(data []byte) error {
+	var item struct {
+		ContractCode       string        `json:"contractCode"`
+		LatestPrice        FloatOrString `json:"latestPrice"`
+		LatestSize         FloatOrString `json:"latestSize"`
+		LatestTime         int64         `json:"latestTime"`
+		BidPrice           FloatOrString `json:"bidPrice"`
+		BidSize            FloatOrString `json:"bidSize"`
+		AskPrice           FloatOrString `json:"askPrice"`
+		AskSize            FloatOrString `json:"askSize"`
+		Open               FloatOrString `json:"open"`
+		High               FloatOrString `json:"high"`
+		Low                FloatOrString `json:"low"`
+		Settlement         FloatOrString `json:"settlement"`
+		LimitUp            FloatOrString `json:"limitUp"`
+		LimitDown          FloatOrString `json:"limitDown"`
+		Volume             FloatOrString `json:"volume"`
+		OpenInterest       FloatOrString `json:"openInterest"`
+		OpenInterestChange FloatOrString `json:"openInterestChange"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	*b = FutureBrief{
+		ContractCode:       item.ContractCode,
+		LatestPrice:        float64(item.LatestPrice),
+		LatestSize:         int64(item.LatestSize),
+		LatestTime:         timeFromMillis(item.LatestTime),
+		BidPrice:           float64(item.BidPrice),
+		BidSize:            int64(item.BidSize),
+		AskPrice:           float64(item.AskPrice),
+		AskSize:            int64(item.AskSize),
+		Open:               float64(item.Open),
+		High:               float64(item.High),
+		Low:                float64(item.Low),
+		Settlement:         float64(item.Settlement),
+		LimitUp:            float64(item.LimitUp),
+		LimitDown:          float64(item.LimitDown),
+		Volume:             int64(item.Volume),
+		OpenInterest:       int64(item.OpenInterest),
+		OpenInterestChange: int64(item.OpenInterestChange),
+		Raw:                append(json.RawMessage(nil), data...),
+	}
+	return nil
+}
+
+type FutureBriefsResult struct {
+	Response APIResponse // 多批次请求时为最后一批的响应。
+	Briefs   []FutureBrief
+}
+
+// GetFutureBriefs 查询期货实时行情，超过单次上限的合约会自动分批请求。
+func (q *QuoteClient) GetFutureBriefs(ctx context.Context, contractCodes []string) (*FutureBriefsResult, error) {
+	if len(contractCodes) == 0 {
+		return nil, fmt.Errorf("%w: future_real_time_quote requires contract codes", ErrInvalidParameter)
+	}
+	result := &FutureBriefsResult{}
+	for _, batch := range batchStrings(contractCodes, maxFutureContracts) {
+		biz := q.biz()
+		biz["contract_codes"] = batch
+		resp, err := q.client.call(ctx, "future_real_time_quote", biz)
+		if err != nil {
+			return nil, err
+		}
+		result.Response = resp
+		if err := checkResponse("future_real_time_quote", resp, 0, ""); err != nil {
+			return result, err
+		}
+		var briefs []FutureBrief
+		if err := decodeSeries(resp.Data, &briefs); err != nil {
+			return nil, fmt.Errorf("decode future_real_time_quote data: %w", err)
+		}
+		result.Briefs = append(result.Briefs, briefs...)
+	}
+	return result, nil
+}
+
+// FutureBarsRequest 是 future_kline 的查询条件。PageToken 仅在单个合约时生效。
+type FutureBarsRequest struct {
+	ContractCodes []string
+	Period        BarPeriod
+	BeginTime     time.Time
+	EndTime       time.Time
+	Limit         int
+	PageToken     string
+	Language      string
+}
+
+func (r FutureBarsRequest) toBiz(cfg Config) map[string]interface{} {
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	// 网关要求 begin_time/end_time 必填，-1 表示不限制。
+	biz := map[string]interface{}{
+		"begin_time": int64(-1),
+		"end_time":   int64(-1),
+	}
+	if len(r.ContractCodes) > 0 {
+		biz["contract_codes"] = r.ContractCodes
+	}
+	if r.Period != "" {
+		biz["period"] = string(r.Period)
+	}
+	if !r.BeginTime.IsZero() {
+		biz["begin_time"] = r.BeginTime.UnixMilli()
+	}
+	if !r.EndTime.IsZero() {
+		biz["end_time"] = r.EndTime.UnixMilli()
+	}
+	if r.Limit > 0 {
+		biz["limit"] = r.Limit
+	}
+	if r.PageToken != "" {
+		biz["page_token"] = r.PageToken
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+func (r FutureBarsRequest) validate() error {
+	if len(r.ContractCodes) == 0 {
+		return fmt.Errorf("%w: future_kline requires contract codes", ErrInvalidParameter)
+	}
+	if len(r.ContractCodes) > maxFutureContracts {
+		return fmt.Errorf("%w: future_kline accepts at most %d contracts, got %d", ErrInvalidParameter, maxFutureContracts, len(r.ContractCodes))
+	}
+	if !barPeriods[r.Period] {
+		return fmt.Errorf("%w: unknown bar period %q", ErrInvalidParameter, string(r.Period))
+	}
+	if r.PageToken != "" && len(r.ContractCodes) != 1 {
+		return fmt.Errorf("%w: page token requires a single contract", ErrInvalidParameter)
+	}
+	if !r.BeginTime.IsZero() && !r.EndTime.IsZero() && r.EndTime.Before(r.BeginTime) {
+		return fmt.Errorf("%w: end time before begin time", ErrInvalidParameter)
+	}
+	return nil
+}
+
+// FutureBar 是期货 K 线，附带结算价、持仓量与该周期最后一笔成交时间。
+type FutureBar struct {
+	Bar
+	LastTime     time.Time
+	Settlement   float64
+	OpenInterest int64
+}
+
+func (b *FutureBar) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &b.Bar); err != nil {
+		return err
+	}
+	var item struct {
+		LastTime     int64         `json:"lastTime"`
+		Settlement   FloatOrString `json:"settlement"`
+		OpenInterest FloatOrString `json:"openInterest"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	b.LastTime = timeFromMillis(item.LastTime)
+	b.Settlement = float64(item.Settlement)
+	b.OpenInterest = int64(item.OpenInterest)
+	return nil
+}
+
+// FutureBarSeries 是单个合约的一段 K 线，NextPageToken 非空时表示还有更多数据。
+type FutureBarSeries struct {
+	ContractCode  string      `json:"contractCode"`
+	NextPageToken string      `json:"nextPageToken,omitempty"`
+	Bars          []FutureBar `json:"items"`
+}
+
+type FutureBarsResult struct {
+	Response APIResponse
+	Series   []FutureBarSeries
+}
+
+// SeriesFor 返回指定合约的 K 线序列，不存在时返回 nil。
+func (r FutureBarsResult) SeriesFor(contractCode string) *FutureBarSeries {
+	for i := range r.Series {
+		if r.Series[i].ContractCode == contractCode {
+			return &r.Series[i]
+		}
+	}
+	return nil
+}
+
+// GetFutureBars 查询期货 K 线，单次最多 50 个合约，分页请使用返回的 NextPageToken。
+func (q *QuoteClient) GetFutureBars(ctx context.Context, req FutureBarsRequest) (*FutureBarsResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	biz := req.toBiz(q.client.cfg)
+	resp, err := q.client.call(ctx, "future_kline", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("future_kline", resp, 0, ""); err != nil {
+		return &FutureBarsResult{Response: resp}, err
+	}
+	result := &FutureBarsResult{Response: resp}
+	if err := decodeSeries(resp.Data, &result.Series); err != nil {
+		return nil, fmt.Errorf("decode future_kline data: %w", err)
+	}
+	return result, nil
+}
+
+// FutureTicksRequest 是 future_tick 的查询条件，BeginIndex/EndIndex 为逐笔序号区间。
+type FutureTicksRequest struct {
+	ContractCode string
+	BeginIndex   *int64
+	EndIndex     *int64
+	Limit        int
+	Language     string
+}
+
+func (r FutureTicksRequest) toBiz(cfg Config) map[string]interface{} {
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if r.ContractCode != "" {
+		biz["contract_code"] = r.ContractCode
+	}
+	if r.BeginIndex != nil {
+		biz["begin_index"] = *r.BeginIndex
+	}
+	if r.EndIndex != nil {
+		biz["end_index"] = *r.EndIndex
+	}
+	if r.Limit > 0 {
+		biz["limit"] = r.Limit
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+// FutureTickSeries 是单个合约的逐笔成交，期货逐笔不区分主动方向。
+type FutureTickSeries struct {
+	ContractCode string
+	Ticks        []TradeTick
+}
+
+func (s *FutureTickSeries) UnmarshalJSON(data []byte) error {
+	var item struct {
+		ContractCode string `json:"contractCode"`
+		Items        []struct {
+			Index  int64         `json:"index"`
+			Time   int64         `json:"time"`
+			Price  FloatOrString `json:"price"`
+			Volume FloatOrString `json:"volume"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	*s = FutureTickSeries{ContractCode: item.ContractCode}
+	for _, tick := range item.Items {
+		s.Ticks = append(s.Ticks, TradeTick{
+			Index:  tick.Index,
+			Time:   timeFromMillis(tick.Time),
+			Price:  float64(tick.Price),
+			Volume: int64(tick.Volume),
+		})
+	}
+	return nil
+}
+
+type FutureTicksResult struct {
+	Response APIResponse
+	Series   FutureTickSeries
+}
+
+// GetFutureTicks 查询期货逐笔成交。
+func (q *QuoteClient) GetFutureTicks(ctx context.Context, req FutureTicksRequest) (*FutureTicksResult, error) {
+	if req.ContractCode == "" {
+		return nil, fmt.Errorf("%w: future_tick requires contract code", ErrInvalidParameter)
+	}
+	if req.BeginIndex != nil && req.EndIndex != nil && *req.EndIndex < *req.BeginIndex {
+		return nil, fmt.Errorf("%w: end index before begin index", ErrInvalidParameter)
+	}
+	biz := req.toBiz(q.client.cfg)
+	resp, err := q.client.call(ctx, "future_tick", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("future_tick", resp, 0, ""); err != nil {
+		return &FutureTicksResult{Response: resp}, err
+	}
+	result := &FutureTicksResult{Response: resp}
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &result.Series); err != nil {
+			return nil, fmt.Errorf("decode future_tick data: %w", err)
+		}
+	}
+	if result.Series.ContractCode == "" {
+		result.Series.ContractCode = req.ContractCode
+	}
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package tigeropen
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFutureTypeContractQueriesSendType(t *testing.T) {
+	var got []map[string]interface{}
+	requests := 0
+	client := pagedGateway(t, &requests, func(biz map[string]interface{}) interface{} {
+		got = append(got, biz)
+		return []interface{}{map[string]interface{}{"contractCode": "CL2412", "type": "CL"}}
+	})
+	ctx := context.Background()
+	if _, err := client.Quote().GetFutureCurrentContract(ctx, "CL"); err != nil {
+		t.Fatalf("GetFutureCurrentContract: %v", err)
+	}
+	if _, err := client.Quote().GetFutureContinuousContracts(ctx, "CL"); err != nil {
+		t.Fatalf("GetFutureContinuousContracts: %v", err)
+	}
+	for _, biz := range got {
+		if biz["type"] != "CL" {
+			t.Errorf("biz_content %v missing type", biz)
+		}
+		if _, ok := biz["contract_code"]; ok {
+			t.Errorf("biz_content %v must not send contract_code", biz)
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TradeSession 是行情查询使用的交易时段。
+type TradeSession string
+
+const (
+	TradeSessionPreMarket  TradeSession = "PreMarket"
+	TradeSessionRegular    TradeSession = "Regular"
+	TradeSessionAfterHours TradeSession = "AfterHours"
+	TradeSessionOverNight  TradeSession = "OverNight"
+)
+
+// TimelineRequest 是 time_line 的查询条件，IncludeHourTrading 为 true 时返回盘前盘后分时。
+type TimelineRequest struct {
+	Symbols            []string
+	IncludeHourTrading bool
+	BeginTime          time.Time
+	TradeSession       TradeSession
+	Language           string
+}
+
+func (r TimelineRequest) toBiz(cfg Config) map[string]interface{} {
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if len(r.Symbols) > 0 {
+		biz["symbols"] = r.Symbols
+	}
+	if r.IncludeHourTrading {
+		biz["include_hour_trading"] = true
+	}
+	if !r.BeginTime.IsZero() {
+		biz["begin_time"] = r.BeginTime.UnixMilli()
+	}
+	if r.TradeSession != "" {
+		biz["trade_session"] = string(r.TradeSession)
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+// TimelinePoint 是分时线上的一个点。
+type TimelinePoint struct {
+	Time     time.Time
+	Price    float64
+	AvgPrice float64
+	Volume   int64
+}
+
+func (p *TimelinePoint) UnmarshalJSON(data []byte) error {
+	var item struct {
+		Time     int64         `json:"time"`
+		Price    FloatOrString `json:"price"`
+		AvgPrice FloatOrString `json:"avgPrice"`
+		Volume   FloatOrString `json:"volume"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	*p = TimelinePoint{
+		Time:     timeFromMillis(item.Time),
+		Price:    float64(item.Price),
+		AvgPrice: float64(item.AvgPrice),
+		Volume:   int64(item.Volume),
+	}
+	return nil
+}
+
+type timelineSection struct {
+	Items []TimelinePoint `json:"items"`
+}
+
+// Timeline 是单个标的的当日分时，PreMarket 与 AfterHours 仅在请求盘前盘后时返回。
+type Timeline struct {
+	Symbol     string
+	PreClose   float64
+	Intraday   []TimelinePoint
+	PreMarket  []TimelinePoint
+	AfterHours []TimelinePoint
+}
+
+func (t *Timeline) UnmarshalJSON(data []byte) error {
+	var item struct {
+		Symbol     string           `json:"symbol"`
+		PreClose   FloatOrString    `json:"preClose"`
+		Intraday   *timelineSection `json:"intraday"`
+		PreMarket  *timelineSection `json:"preMarket"`
+		AfterHours *timelineSection `json:"afterHours"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	*t = Timeline{Symbol: item.Symbol, PreClose: float64(item.PreClose)}
+	if item.Intraday != nil {
+		t.Intraday = item.Intraday.Items
+	}
+	if item.PreMarket != nil {
+		t.PreMarket = item.PreMarket.Items
+	}
+	if item.AfterHours != nil {
+		t.AfterHours = item.AfterHours.Items
+	}
+	return nil
+}
+
+type TimelineResult struct {
+	Response  APIResponse
+	Timelines []Timeline
+}
+
+// GetTimeline 查询当日分时数据。
+func (q *QuoteClient) GetTimeline(ctx context.Context, req TimelineRequest) (*TimelineResult, error) {
+	if len(req.Symbols) == 0 {
+		return nil, fmt.Errorf("%w: time_line requires symbols", ErrInvalidParameter)
+	}
+	biz := req.toBiz(q.client.cfg)
+	resp, err := q.client.call(ctx, "time_line", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("time_line", resp, 0, ""); err != nil {
+		return &TimelineResult{Response: resp}, err
+	}
+	result := &TimelineResult{Response: resp}
+	if err := decodeSeries(resp.Data, &result.Timelines); err != nil {
+		return nil, fmt.Errorf("decode time_line data: %w", err)
+	}
+	return result, nil
+}
+
+// TickDirection 是逐笔成交的主动方向。
+type TickDirection int
+
+const (
+	TickNeutral TickDirection = iota // 网关返回 "*"。
+	TickBuy                          // 主动买入，网关返回 "+"。
+	TickSell                         // 主动卖出，网关返回 "-"。
+)
+
+func (d TickDirection) String() string {
+	switch d {
+	case TickBuy:
+		return "buy"
+	case TickSell:
+		return "sell"
+	}
+	return "neutral"
+}
+
+// TradeTicksRequest 是 trade_tick 的查询条件，BeginIndex/EndIndex 为逐笔序号区间。
+type TradeTicksRequest struct {
+	Symbols      []string
+	BeginIndex   *int64
+	EndIndex     *int64
+	Limit        int
+	TradeSession TradeSession
+	Language     string
+}
+
+func (r TradeTicksRequest) toBiz(cfg Config) map[string]interface{} {
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if len(r.Symbols) > 0 {
+		biz["symbols"] = r.Symbols
+	}
+	if r.BeginIndex != nil {
+		biz["begin_index"] = *r.BeginIndex
+	}
+	if r.EndIndex != nil {
+		biz["end_index"] = *r.EndIndex
+	}
+	if r.Limit > 0 {
+		biz["limit"] = r.Limit
+	}
+	if r.TradeSession != "" {
+		biz["trade_session"] = string(r.TradeSession)
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+// TradeTick 是一笔逐笔成交。
+type TradeTick struct {
+	Index     int64
+	Time      time.Time
+	Price     float64
+	Volume    int64
+	Direction TickDirection
+}
+
+// TickSeries 是单个标的的逐笔成交，BeginIndex/EndIndex 可用于继续翻页。
+type TickSeries struct {
+	Symbol     string
+	BeginIndex int64
+	EndIndex   int64
+	Ticks      []TradeTick
+}
+
+func (s *TickSeries) UnmarshalJSON(data []byte) error {
+	var item struct {
+		Symbol     string `json:"symbol"`
+		BeginIndex int64  `json:"beginIndex"`
+		EndIndex   int64  `json:"endIndex"`
+		Items      []struct {
+			Index  *int64        `json:"index"`
+			Time   int64         `json:"time"`
+			Price  FloatOrString `json:"price"`
+			Volume FloatOrString `json:"volume"`
+			Type   string        `json:"type"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	*s = TickSeries{Symbol: item.Symbol, BeginIndex: item.BeginIndex, EndIndex: item.EndIndex}
+	for i, tick := range item.Items {
+		index := item.BeginIndex + int64(i)
+		if tick.Index != nil {
+			index = *tick.Index
+		}
+		direction := TickNeutral
+		switch tick.Type {
+		case "+":
+			direction = TickBuy
+		case "-":
+			direction = TickSell
+		}
+		s.Ticks = append(s.Ticks, TradeTick{
+			Index:     index,
+			Time:      timeFromMillis(tick.Time),
+			Price:     float64(tick.Price),
+			Volume:    int64(tick.Volume),
+			Direction: direction,
+		})
+	}
+	return nil
+}
+
+type TradeTicksResult struct {
+	Response APIResponse
+	Series   []TickSeries
+}
+
+// GetTradeTicks 查询逐笔成交。
+func (q *QuoteClient) GetTradeTicks(ctx context.Context, req TradeTicksRequest) (*TradeTicksResult, error) {
+	if len(req.Symbols) == 0 {
+		return nil, fmt.Errorf("%w: trade_tick requires symbols", ErrInvalidParameter)
+	}
+	if req.BeginIndex != nil && req.EndIndex != nil && *req.EndIndex < *req.BeginIndex {
+		return nil, fmt.Errorf("%w: end index before begin index", ErrInvalidParameter)
+	}
+	biz := req.toBiz(q.client.cfg)
+	resp, err := q.client.call(ctx, "trade_tick", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("trade_tick", resp, 0, ""); err != nil {
+		return &TradeTicksResult{Response: resp}, err
+	}
+	result := &TradeTicksResult{Response: resp}
+	if err := decodeSeries(resp.Data, &result.Series); err != nil {
+		return nil, fmt.Errorf("decode trade_tick data: %w", err)
+	}
+	return result, nil
+}
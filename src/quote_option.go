@@ -0,0 +1,670 @@
+package tigeropen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxOptionContracts 是 option_brief / option_kline 单次请求允许的最大合约数。
+const maxOptionContracts = 30
+
+// 期权方向。
+const (
+	PutCallCall = "CALL"
+	PutCallPut  = "PUT"
+)
+
+// optionMarket 返回期权请求使用的市场，默认美股。
+func optionMarket(market string) string {
+	if market == "" {
+		return MarketUS
+	}
+	return strings.ToUpper(market)
+}
+
+// optionExpiryMillis 将 YYYYMMDD 或 YYYY-MM-DD 形式的到期日转为市场当地零点的毫秒时间戳。
+func optionExpiryMillis(expiry string, loc *time.Location) (int64, error) {
+	for _, layout := range []string{"20060102", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, expiry, loc); err == nil {
+			return t.UnixMilli(), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: invalid option expiry %q", ErrInvalidParameter, expiry)
+}
+
+// parseOptionExpiry 解析网关返回的到期日（毫秒时间戳或日期字符串），统一为 Contract 使用的 YYYYMMDD。
+func parseOptionExpiry(raw json.RawMessage, loc *time.Location) string {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return ""
+	}
+	s := string(trimmed)
+	if trimmed[0] == '"' {
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return ""
+		}
+	}
+	if len(s) == len("2006-01-02") && strings.Count(s, "-") == 2 {
+		return strings.ReplaceAll(s, "-", "")
+	}
+	if len(s) == len("20060102") {
+		return s
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || ms == 0 {
+		return ""
+	}
+	return time.UnixMilli(ms).In(loc).Format("20060102")
+}
+
+// optionContractBiz 将期权 Contract 转为行情接口使用的合约参数。
+func optionContractBiz(c Contract, loc *time.Location) (map[string]interface{}, error) {
+	if c.Symbol == "" || c.Expiry == "" || c.Strike == nil || c.PutCall == "" {
+		return nil, fmt.Errorf("%w: option contract requires symbol, expiry, strike and put/call", ErrInvalidParameter)
+	}
+	expiry, err := optionExpiryMillis(c.Expiry, loc)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"symbol": c.Symbol,
+		"expiry": expiry,
+		"right":  strings.ToUpper(c.PutCall),
+		"strike": strconv.FormatFloat(*c.Strike, 'f', -1, 64),
+	}, nil
+}
+
+// OptionExpiration 是标的的一个期权到期日。
+type OptionExpiration struct {
+	Symbol    string
+	Expiry    string    // YYYYMMDD，可直接填入 Contract.Expiry。
+	Date      time.Time // 市场当地时区的零点。
+	PeriodTag string    // 周期权 w、月期权 m，网关未返回时为空。
+}
+
+type OptionExpirationsResult struct {
+	Response    APIResponse
+	Expirations []OptionExpiration
+}
+
+// ExpirationsFor 返回指定标的的到期日，按日期升序。
+func (r OptionExpirationsResult) ExpirationsFor(symbol string) []OptionExpiration {
+	var out []OptionExpiration
+	for _, e := range r.Expirations {
+		if e.Symbol == symbol {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// GetOptionExpirations 查询标的的期权到期日，market 为空时默认美股。
+func (q *QuoteClient) GetOptionExpirations(ctx context.Context, symbols []string, market string) (*OptionExpirationsResult, error) {
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("%w: option_expiration requires symbols", ErrInvalidParameter)
+	}
+	market = optionMarket(market)
+	loc := MarketLocation(market)
+	biz := q.biz()
+	biz["symbols"] = symbols
+	biz["market"] = market
+	resp, err := q.client.call(ctx, "option_expiration", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("option_expiration", resp, 0, ""); err != nil {
+		return &OptionExpirationsResult{Response: resp}, err
+	}
+	var items []struct {
+		Symbol     string            `json:"symbol"`
+		Dates      []string          `json:"dates"`
+		Timestamps []json.RawMessage `json:"timestamps"`
+		PeriodTags []string          `json:"periodTags"`
+	}
+	if err := decodeSeries(resp.Data, &items); err != nil {
+		return nil, fmt.Errorf("decode option_expiration data: %w", err)
+	}
+	result := &OptionExpirationsResult{Response: resp}
+	for _, item := range items {
+		count := len(item.Dates)
+		if len(item.Timestamps) > count {
+			count = len(item.Timestamps)
+		}
+		var expirations []OptionExpiration
+		for i := 0; i < count; i++ {
+			var expiry string
+			if i < len(item.Dates) {
+				expiry = parseOptionExpiry(json.RawMessage(strconv.Quote(item.Dates[i])), loc)
+			}
+			if expiry == "" && i < len(item.Timestamps) {
+				expiry = parseOptionExpiry(item.Timestamps[i], loc)
+			}
+			if expiry == "" {
+				continue
+			}
+			date, err := time.ParseInLocation("20060102", expiry, loc)
+			if err != nil {
+				return nil, fmt.Errorf("parse option expiry %q: %w", expiry, err)
+			}
+			e := OptionExpiration{Symbol: item.Symbol, Expiry: expiry, Date: date}
+			if i < len(item.PeriodTags) {
+				e.PeriodTag = item.PeriodTags[i]
+			}
+			expirations = append(expirations, e)
+		}
+		sort.SliceStable(expirations, func(i, j int) bool {
+			return expirations[i].Date.Before(expirations[j].Date)
+		})
+		result.Expirations = append(result.Expirations, expirations...)
+	}
+	return result, nil
+}
+
+// OptionQuote 是单个期权合约的行情，期权链与期权快照共用。希腊值仅在网关返回时非零。
+type OptionQuote struct {
+	Identifier   string
+	Symbol       string
+	Expiry       string // YYYYMMDD。
+	Strike       float64
+	PutCall      string
+	Multiplier   float64
+	LatestPrice  float64
+	PreClose     float64
+	Open         float64
+	High         float64
+	Low          float64
+	BidPrice     float64
+	BidSize      int64
+	AskPrice     float64
+	AskSize      int64
+	Volume       int64
+	OpenInterest int64
+	ImpliedVol   float64
+	Delta        float64
+	Gamma        float64
+	Theta        float64
+	Vega         float64
+	Rho          float64
+	LatestTime   time.Time
+	Raw          json.RawMessage
+}
+
+type optionQuoteItem struct {
+	Identifier   string          `json:"identifier"`
+	Symbol       string          `json:"symbol"`
+	Expiry       json.RawMessage `json:"expiry"`
+	Strike       FloatOrString   `json:"strike"`
+	Right        string          `json:"right"`
+	PutCall      string          `json:"putCall"`
+	Multiplier   FloatOrString   `json:"multiplier"`
+	LatestPrice  FloatOrString   `json:"latestPrice"`
+	PreClose     FloatOrString   `json:"preClose"`
+	Open         FloatOrString   `json:"open"`
+	High         FloatOrString   `json:"high"`
+	Low          FloatOrString   `json:"low"`
+	BidPrice     FloatOrString   `json:"bidPrice"`
+	BidSize      FloatOrString   `json:"bidSize"`
+	AskPrice     FloatOrString   `json:"askPrice"`
+	AskSize      FloatOrString   `json:"askSize"`
+	Volume       FloatOrString   `json:"volume"`
+	OpenInterest FloatOrString   `json:"openInterest"`
+	ImpliedVol   FloatOrString   `json:"impliedVol"`
+	Volatility   FloatOrString   `json:"volatility"`
+	Delta        FloatOrString   `json:"delta"`
+	Gamma        FloatOrString   `json:"gamma"`
+	Theta        FloatOrString   `json:"theta"`
+	Vega         FloatOrString   `json:"vega"`
+	Rho          FloatOrString   `json:"rho"`
+	LatestTime   int64           `json:"latestTime"`
+	Timestamp    int64           `json:"timestamp"`
+}
+
+func decodeOptionQuote(raw json.RawMessage, loc *time.Location) (OptionQuote, error) {
+	var item optionQuoteItem
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return OptionQuote{}, err
+	}
+	putCall := item.Right
+	if putCall == "" {
+		putCall = item.PutCall
+	}
+	impliedVol := item.ImpliedVol
+	if impliedVol == 0 {
+		impliedVol = item.Volatility
+	}
+	latest := item.LatestTime
+	if latest == 0 {
+		latest = item.Timestamp
+	}
+	return OptionQuote{
+		Identifier:   item.Identifier,
+		Symbol:       item.Symbol,
+		Expiry:       parseOptionExpiry(item.Expiry, loc),
+		Strike:       float64(item.Strike),
+		PutCall:      strings.ToUpper(putCall),
+		Multiplier:   float64(item.Multiplier),
+		LatestPrice:  float64(item.LatestPrice),
+		PreClose:     float64(item.PreClose),
+		Open:         float64(item.Open),
+		High:         float64(item.High),
+		Low:          float64(item.Low),
+		BidPrice:     float64(item.BidPrice),
+		BidSize:      int64(item.BidSize),
+		AskPrice:     float64(item.AskPrice),
+		AskSize:      int64(item.AskSize),
+		Volume:       int64(item.Volume),
+		OpenInterest: int64(item.OpenInterest),
+		ImpliedVol:   float64(impliedVol),
+		Delta:        float64(item.Delta),
+		Gamma:        float64(item.Gamma),
+		Theta:        float64(item.Theta),
+		Vega:         float64(item.Vega),
+		Rho:          float64(item.Rho),
+		LatestTime:   timeFromMillis(latest),
+		Raw:          raw,
+	}, nil
+}
+
+// ToContract 将期权行情转为下单使用的 Contract。
+func (o OptionQuote) ToContract() Contract {
+	strike := o.Strike
+	contract := Contract{
+		Symbol:  o.Symbol,
+		SecType: "OPT",
+		Expiry:  o.Expiry,
+		Strike:  &strike,
+		PutCall: o.PutCall,
+	}
+	if o.Multiplier != 0 {
+		contract.Multiplier = strconv.FormatFloat(o.Multiplier, 'f', -1, 64)
+	}
+	return contract
+}
+
+// Leg 返回组合订单中的一条腿。
+func (o OptionQuote) Leg(action string, ratio int) ContractLeg {
+	return ContractLeg{Contract: o.ToContract(), Action: action, Ratio: ratio}
+}
+
+// OptionChainRequest 是 option_chain 的查询条件。Delta、持仓量与价内过滤由网关完成，
+// 行权价区间在本地过滤。
+type OptionChainRequest struct {
+	Symbol          string
+	Expiry          string // YYYYMMDD 或 YYYY-MM-DD。
+	Market          string // 为空时默认美股。
+	StrikeMin       *float64
+	StrikeMax       *float64
+	DeltaMin        *float64
+	DeltaMax        *float64
+	OpenInterestMin *int64
+	OpenInterestMax *int64
+	InTheMoney      *bool
+	ReturnGreeks    bool
+	Language        string
+}
+
+func (r OptionChainRequest) validate() error {
+	if r.Symbol == "" || r.Expiry == "" {
+		return fmt.Errorf("%w: option_chain requires symbol and expiry", ErrInvalidParameter)
+	}
+	if r.StrikeMin != nil && r.StrikeMax != nil && *r.StrikeMax < *r.StrikeMin {
+		return fmt.Errorf("%w: strike max below strike min", ErrInvalidParameter)
+	}
+	if r.DeltaMin != nil && r.DeltaMax != nil && *r.DeltaMax < *r.DeltaMin {
+		return fmt.Errorf("%w: delta max below delta min", ErrInvalidParameter)
+	}
+	if r.OpenInterestMin != nil && r.OpenInterestMax != nil && *r.OpenInterestMax < *r.OpenInterestMin {
+		return fmt.Errorf("%w: open interest max below open interest min", ErrInvalidParameter)
+	}
+	return nil
+}
+
+func (r OptionChainRequest) toBiz(cfg Config) (map[string]interface{}, error) {
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+	market := optionMarket(r.Market)
+	expiry, err := optionExpiryMillis(r.Expiry, MarketLocation(market))
+	if err != nil {
+		return nil, err
+	}
+
+	biz := map[string]interface{}{
+		"option_basis": []map[string]interface{}{{"symbol": r.Symbol, "expiry": expiry}},
+		"market":       market,
+	}
+	filter := map[string]interface{}{}
+	if r.DeltaMin != nil || r.DeltaMax != nil {
+		filter["greeks"] = map[string]interface{}{"delta": rangeFilter(r.DeltaMin, r.DeltaMax)}
+	}
+	if r.OpenInterestMin != nil || r.OpenInterestMax != nil {
+		oi := map[string]interface{}{}
+		if r.OpenInterestMin != nil {
+			oi["min"] = *r.OpenInterestMin
+		}
+		if r.OpenInterestMax != nil {
+			oi["max"] = *r.OpenInterestMax
+		}
+		filter["open_interest"] = oi
+	}
+	if r.InTheMoney != nil {
+		filter["in_the_money"] = *r.InTheMoney
+	}
+	if len(filter) > 0 {
+		biz["option_filter"] = filter
+	}
+	if r.ReturnGreeks || len(filter) > 0 {
+		biz["return_greek_value"] = true
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz, nil
+}
+
+func rangeFilter(min, max *float64) map[string]interface{} {
+	out := map[string]interface{}{}
+	if min != nil {
+		out["min"] = *min
+	}
+	if max != nil {
+		out["max"] = *max
+	}
+	return out
+}
+
+// OptionChainRow 是期权链上同一行权价的看涨与看跌合约，任一方向可能为 nil。
+type OptionChainRow struct {
+	Strike float64
+	Call   *OptionQuote
+	Put    *OptionQuote
+}
+
+// OptionChain 是标的在某个到期日的期权链，按行权价升序。
+type OptionChain struct {
+	Symbol string
+	Expiry string
+	Rows   []OptionChainRow
+}
+
+type OptionChainResult struct {
+	Response APIResponse
+	Chains   []OptionChain
+}
+
+// GetOptionChain 查询期权链。
+func (q *QuoteClient) GetOptionChain(ctx context.Context, req OptionChainRequest) (*OptionChainResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	biz, err := req.toBiz(q.client.cfg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := q.client.call(ctx, "option_chain", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("option_chain", resp, 0, ""); err != nil {
+		return &OptionChainResult{Response: resp}, err
+	}
+	var items []struct {
+		Symbol string          `json:"symbol"`
+		Expiry json.RawMessage `json:"expiry"`
+		Items  []struct {
+			Call json.RawMessage `json:"call"`
+			Put  json.RawMessage `json:"put"`
+		} `json:"items"`
+	}
+	if err := decodeSeries(resp.Data, &items); err != nil {
+		return nil, fmt.Errorf("decode option_chain data: %w", err)
+	}
+	loc := MarketLocation(optionMarket(req.Market))
+	result := &OptionChainResult{Response: resp}
+	for _, item := range items {
+		chain := OptionChain{Symbol: item.Symbol, Expiry: parseOptionExpiry(item.Expiry, loc)}
+		if chain.Expiry == "" {
+			chain.Expiry = strings.ReplaceAll(req.Expiry, "-", "")
+		}
+		for _, pair := range item.Items {
+			var row OptionChainRow
+			for _, side := range []struct {
+				raw     json.RawMessage
+				dst     **OptionQuote
+				putCall string
+			}{{pair.Call, &row.Call, PutCallCall}, {pair.Put, &row.Put, PutCallPut}} {
+				raw := bytes.TrimSpace(side.raw)
+				if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+					continue
+				}
+				quote, err := decodeOptionQuote(raw, loc)
+				if err != nil {
+					return nil, fmt.Errorf("decode option chain item: %w", err)
+				}
+				if quote.Symbol == "" {
+					quote.Symbol = chain.Symbol
+				}
+				if quote.Expiry == "" {
+					quote.Expiry = chain.Expiry
+				}
+				if quote.PutCall == "" {
+					quote.PutCall = side.putCall
+				}
+				row.Strike = quote.Strike
+				*side.dst = &quote
+			}
+			if row.Call == nil && row.Put == nil {
+				continue
+			}
+			if req.StrikeMin != nil && row.Strike < *req.StrikeMin {
+				continue
+			}
+			if req.StrikeMax != nil && row.Strike > *req.StrikeMax {
+				continue
+			}
+			chain.Rows = append(chain.Rows, row)
+		}
+		sort.SliceStable(chain.Rows, func(i, j int) bool {
+			return chain.Rows[i].Strike < chain.Rows[j].Strike
+		})
+		result.Chains = append(result.Chains, chain)
+	}
+	return result, nil
+}
+
+type OptionBriefsResult struct {
+	Response APIResponse // 多批次请求时为最后一批的响应。
+	Quotes   []OptionQuote
+}
+
+// GetOptionBriefs 查询期权行情快照，contracts 需包含 Symbol、Expiry、Strike 与 PutCall，超过单次上限会自动分批请求。
+func (q *QuoteClient) GetOptionBriefs(ctx context.Context, contracts []Contract, market string) (*OptionBriefsResult, error) {
+	if len(contracts) == 0 {
+		return nil, fmt.Errorf("%w: option_brief requires contracts", ErrInvalidParameter)
+	}
+	market = optionMarket(market)
+	loc := MarketLocation(market)
+	params := make([]map[string]interface{}, 0, len(contracts))
+	for _, c := range contracts {
+		p, err := optionContractBiz(c, loc)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, p)
+	}
+	result := &OptionBriefsResult{}
+	for start := 0; start < len(params); start += maxOptionContracts {
+		end := start + maxOptionContracts
+		if end > len(params) {
+			end = len(params)
+		}
+		biz := q.biz()
+		biz["contracts"] = params[start:end]
+		biz["market"] = market
+		resp, err := q.client.call(ctx, "option_brief", biz)
+		if err != nil {
+			return nil, err
+		}
+		result.Response = resp
+		if err := checkResponse("option_brief", resp, 0, ""); err != nil {
+			return result, err
+		}
+		items, err := unwrapItems(resp.Data)
+		if err != nil {
+			return nil, fmt.Errorf("decode option_brief data: %w", err)
+		}
+		for _, raw := range items {
+			quote, err := decodeOptionQuote(raw, loc)
+			if err != nil {
+				return nil, fmt.Errorf("decode option brief item: %w", err)
+			}
+			result.Quotes = append(result.Quotes, quote)
+		}
+	}
+	return result, nil
+}
+
+// OptionBarsRequest 是 option_kline 的查询条件，Contracts 需包含 Symbol、Expiry、Strike 与 PutCall。
+type OptionBarsRequest struct {
+	Contracts []Contract
+	Market    string // 为空时默认美股。
+	Period    BarPeriod
+	BeginTime time.Time
+	EndTime   time.Time
+	Limit     int
+	Language  string
+}
+
+// OptionBar 是期权 K 线，附带持仓量。
+type OptionBar struct {
+	Bar
+	OpenInterest int64
+}
+
+func (b *OptionBar) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &b.Bar); err != nil {
+		return err
+	}
+	var item struct {
+		OpenInterest FloatOrString `json:"openInterest"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	b.OpenInterest = int64(item.OpenInterest)
+	return nil
+}
+
+// OptionBarSeries 是单个期权合约的 K 线。
+type OptionBarSeries struct {
+	Identifier string
+	Contract   Contract
+	Period     BarPeriod
+	Bars       []OptionBar
+}
+
+type OptionBarsResult struct {
+	Response APIResponse // 多批次请求时为最后一批的响应。
+	Series   []OptionBarSeries
+}
+
+// GetOptionBars 查询期权 K 线，超过单次上限的合约会自动分批请求。
+func (q *QuoteClient) GetOptionBars(ctx context.Context, req OptionBarsRequest) (*OptionBarsResult, error) {
+	if len(req.Contracts) == 0 {
+		return nil, fmt.Errorf("%w: option_kline requires contracts", ErrInvalidParameter)
+	}
+	if req.Period != "" && !barPeriods[req.Period] {
+		return nil, fmt.Errorf("%w: unknown bar period %q", ErrInvalidParameter, string(req.Period))
+	}
+	if !req.BeginTime.IsZero() && !req.EndTime.IsZero() && req.EndTime.Before(req.BeginTime) {
+		return nil, fmt.Errorf("%w: end time before begin time", ErrInvalidParameter)
+	}
+	lang := req.Language
+	if lang == "" {
+		lang = q.client.cfg.Lang
+	}
+	market := optionMarket(req.Market)
+	loc := MarketLocation(market)
+	params := make([]map[string]interface{}, 0, len(req.Contracts))
+	for _, c := range req.Contracts {
+		p, err := optionContractBiz(c, loc)
+		if err != nil {
+			return nil, err
+		}
+		if req.Period != "" {
+			p["period"] = string(req.Period)
+		}
+		if !req.BeginTime.IsZero() {
+			p["begin_time"] = req.BeginTime.UnixMilli()
+		}
+		if !req.EndTime.IsZero() {
+			p["end_time"] = req.EndTime.UnixMilli()
+		}
+		if req.Limit > 0 {
+			p["limit"] = req.Limit
+		}
+		params = append(params, p)
+	}
+
+	result := &OptionBarsResult{}
+	for start := 0; start < len(params); start += maxOptionContracts {
+		end := start + maxOptionContracts
+		if end > len(params) {
+			end = len(params)
+		}
+		biz := map[string]interface{}{
+			"contracts": params[start:end],
+			"market":    market,
+		}
+		if lang != "" {
+			biz["lang"] = lang
+		}
+		resp, err := q.client.call(ctx, "option_kline", biz)
+		if err != nil {
+			return nil, err
+		}
+		result.Response = resp
+		if err := checkResponse("option_kline", resp, 0, ""); err != nil {
+			return result, err
+		}
+		var items []struct {
+			Identifier string          `json:"identifier"`
+			Symbol     string          `json:"symbol"`
+			Expiry     json.RawMessage `json:"expiry"`
+			Strike     FloatOrString   `json:"strike"`
+			Right      string          `json:"right"`
+			Period     BarPeriod       `json:"period"`
+			Items      []OptionBar     `json:"items"`
+		}
+		if err := decodeSeries(resp.Data, &items); err != nil {
+			return nil, fmt.Errorf("decode option_kline data: %w", err)
+		}
+		for _, item := range items {
+			strike := float64(item.Strike)
+			series := OptionBarSeries{
+				Identifier: item.Identifier,
+				Contract: Contract{
+					Symbol:  item.Symbol,
+					SecType: "OPT",
+					Expiry:  parseOptionExpiry(item.Expiry, loc),
+					Strike:  &strike,
+					PutCall: strings.ToUpper(item.Right),
+				},
+				Period: item.Period,
+				Bars:   item.Items,
+			}
+			if series.Period == "" {
+				series.Period = req.Period
+			}
+			result.Series = append(result.Series, series)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,176 @@
+package tigeropen
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 方法族名称，可作为 Config.RateLimits 的 key 统一覆盖一类方法的配额。
+const (
+	MethodFamilyTrade   = "trade"
+	MethodFamilyQuote   = "quote"
+	MethodFamilyAccount = "account"
+)
+
+// RateLimit 描述单个方法的令牌桶配额：每 Per 时间内允许 Requests 次请求，Burst 为桶容量（为 0 时等于 Requests）。
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+// defaultRateLimits 参考网关文档的频率分级：交易类 120 次/分钟，账户与行情类 60 次/分钟。
+var defaultRateLimits = map[string]RateLimit{
+	MethodFamilyTrade:   {Requests: 120, Per: time.Minute},
+	MethodFamilyQuote:   {Requests: 60, Per: time.Minute},
+	MethodFamilyAccount: {Requests: 60, Per: time.Minute},
+}
+
+// methodFamilies 记录每个方法所属的方法族。新增方法必须在此登记，ratelimit_test.go 会检查包内调用的方法都已登记；
+// 未收录的方法按账户类处理。
+var methodFamilies = map[string]string{
+	"place_order":   MethodFamilyTrade,
+	"modify_order":  MethodFamilyTrade,
+	"cancel_order":  MethodFamilyTrade,
+	"preview_order": MethodFamilyTrade,
+
+	"transfer_segment_fund": MethodFamilyTrade,
+	"cancel_segment_fund":   MethodFamilyTrade,
+	"place_forex_order":     MethodFamilyTrade,
+
+	"assets":                     MethodFamilyAccount,
+	"positions":                  MethodFamilyAccount,
+	"orders":                     MethodFamilyAccount,
+	"active_orders":              MethodFamilyAccount,
+	"inactive_orders":            MethodFamilyAccount,
+	"filled_orders":              MethodFamilyAccount,
+	"order_transactions":         MethodFamilyAccount,
+	"contract":                   MethodFamilyAccount,
+	"contracts":                  MethodFamilyAccount,
+	"estimate_tradable_quantity": MethodFamilyAccount,
+	"prime_assets":               MethodFamilyAccount,
+	"analytics_asset":            MethodFamilyAccount,
+	"accounts":                   MethodFamilyAccount,
+	"segment_fund_history":       MethodFamilyAccount,
+	"transfer_fund":              MethodFamilyAccount,
+	"fund_details":               MethodFamilyAccount,
+
+	"quote_contract":                   MethodFamilyQuote,
+	"market_state":                     MethodFamilyQuote,
+	"trading_calendar":                 MethodFamilyQuote,
+	"quote_real_time":                  MethodFamilyQuote,
+	"quote_delay":                      MethodFamilyQuote,
+	"kline":                            MethodFamilyQuote,
+	"time_line":                        MethodFamilyQuote,
+	"trade_tick":                       MethodFamilyQuote,
+	"quote_depth":                      MethodFamilyQuote,
+	"option_expiration":                MethodFamilyQuote,
+	"option_chain":                     MethodFamilyQuote,
+	"option_brief":                     MethodFamilyQuote,
+	"option_kline":                     MethodFamilyQuote,
+	"future_exchange":                  MethodFamilyQuote,
+	"future_contract_by_exchange_code": MethodFamilyQuote,
+	"future_contract_by_contract_code": MethodFamilyQuote,
+	"future_current_contract":          MethodFamilyQuote,
+	"future_continuous_contracts":      MethodFamilyQuote,
+	"future_trading_date":              MethodFamilyQuote,
+	"future_real_time_quote":           MethodFamilyQuote,
+	"future_kline":                     MethodFamilyQuote,
+	"future_tick":                      MethodFamilyQuote,
+}
+
+func methodFamily(method string) string {
+	if family, ok := methodFamilies[method]; ok {
+		return family
+	}
+	return MethodFamilyAccount
+}
+
+// rateLimiter 为每个方法维护一个独立的令牌桶。
+type rateLimiter struct {
+	mu        sync.Mutex
+	overrides map[string]RateLimit
+	buckets   map[string]*tokenBucket
+}
+
+func newRateLimiter(overrides map[string]RateLimit) *rateLimiter {
+	return &rateLimiter{
+		overrides: overrides,
+		buckets:   map[string]*tokenBucket{},
+	}
+}
+
+// limitFor 按 方法名 > 方法族 > 默认配额 的顺序查找配额。
+func (l *rateLimiter) limitFor(method string) RateLimit {
+	if limit, ok := l.overrides[method]; ok {
+		return limit
+	}
+	family := methodFamily(method)
+	if limit, ok := l.overrides[family]; ok {
+		return limit
+	}
+	return defaultRateLimits[family]
+}
+
+// Wait 阻塞直到 method 有可用令牌或 ctx 结束。
+func (l *rateLimiter) Wait(ctx context.Context, method string) error {
+	l.mu.Lock()
+	bucket, ok := l.buckets[method]
+	if !ok {
+		bucket = newTokenBucket(l.limitFor(method))
+		l.buckets[method] = bucket
+	}
+	l.mu.Unlock()
+	if bucket == nil {
+		return nil
+	}
+	return bucket.wait(ctx)
+}
+
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64 // 每秒补充的令牌数。
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+// newTokenBucket 在配额无效（Requests 或 Per 非正）时返回 nil，表示不限流。
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	if limit.Requests <= 0 || limit.Per <= 0 {
+		return nil
+	}
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = limit.Requests
+	}
+	return &tokenBucket{
+		rate:     float64(limit.Requests) / limit.Per.Seconds(),
+		capacity: float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
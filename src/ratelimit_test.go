@@ -0,0 +1,111 @@
+package tigeropen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// writeMethods 是会改变账户状态的方法，必须按交易类限流且不能默认重试。
+var writeMethods = []string{
+	"place_order", "modify_order", "cancel_order", "preview_order",
+	"place_forex_order", "transfer_segment_fund", "cancel_segment_fund",
+}
+
+var methodNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// calledMethods 收集包内以 (ctx, "method", ...) 形式调用的网关方法名。
+func calledMethods(t *testing.T) map[string]string {
+	t.Helper()
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatalf("glob sources: %v", err)
+	}
+	fset := token.NewFileSet()
+	methods := map[string]string{}
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			t.Fatalf("parse %s: %v", name, err)
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				return true
+			}
+			if ident, ok := call.Args[0].(*ast.Ident); !ok || ident.Name != "ctx" {
+				return true
+			}
+			lit, ok := call.Args[1].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			method, err := strconv.Unquote(lit.Value)
+			if err == nil && methodNamePattern.MatchString(method) {
+				methods[method] = fset.Position(lit.Pos()).String()
+			}
+			return true
+		})
+	}
+	return methods
+}
+
+func TestEveryMethodHasFamily(t *testing.T) {
+	methods := calledMethods(t)
+	if len(methods) == 0 {
+		t.Fatal("no gateway methods found in package sources")
+	}
+	for _, m := range defaultRetryMethods {
+		if _, ok := methods[m]; !ok {
+			methods[m] = "defaultRetryMethods"
+		}
+	}
+	for _, m := range writeMethods {
+		if _, ok := methods[m]; !ok {
+			methods[m] = "writeMethods"
+		}
+	}
+	for method, where := range methods {
+		if _, ok := methodFamilies[method]; !ok {
+			t.Errorf("method %q (%s) is not registered in methodFamilies", method, where)
+		}
+	}
+}
+
+func TestWriteMethodsAreTradeFamily(t *testing.T) {
+	retryable := map[string]bool{}
+	for _, m := range defaultRetryMethods {
+		retryable[m] = true
+	}
+	for _, m := range writeMethods {
+		if family := methodFamily(m); family != MethodFamilyTrade {
+			t.Errorf("write method %q has family %q, want %q", m, family, MethodFamilyTrade)
+		}
+		if retryable[m] {
+			t.Errorf("write method %q must not be retried by default", m)
+		}
+	}
+}
+
+func TestQuoteFamilyOverride(t *testing.T) {
+	limiter := newRateLimiter(map[string]RateLimit{
+		MethodFamilyQuote:   {Requests: 5, Per: 1},
+		MethodFamilyAccount: {Requests: 7, Per: 1},
+	})
+	for _, method := range []string{"quote_real_time", "kline", "option_chain", "future_kline"} {
+		if got := limiter.limitFor(method); got.Requests != 5 {
+			t.Errorf("limitFor(%q) = %+v, want quote override", method, got)
+		}
+	}
+	if got := limiter.limitFor("assets"); got.Requests != 7 {
+		t.Errorf("limitFor(assets) = %+v, want account override", got)
+	}
+}
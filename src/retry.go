@@ -0,0 +1,138 @@
+package tigeropen
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 200 * time.Millisecond
+	defaultRetryMaxBackoff     = 5 * time.Second
+	defaultRetryMultiplier     = 2.0
+	defaultRetryJitter         = 0.2
+)
+
+// defaultRetryMethods 是默认允许自动重试的只读方法。
+var defaultRetryMethods = []string{
+	"assets", "positions", "orders", "active_orders", "inactive_orders", "filled_orders",
+	"order_transactions", "contract", "contracts", "quote_contract", "estimate_tradable_quantity",
+	"prime_assets", "analytics_asset", "accounts", "segment_fund_history",
+	"transfer_fund", "fund_details",
+	"market_state", "trading_calendar", "quote_real_time", "quote_delay",
+	"kline", "time_line", "trade_tick", "quote_depth",
+	"option_expiration", "option_chain", "option_brief", "option_kline",
+	"future_exchange", "future_contract_by_exchange_code", "future_contract_by_contract_code",
+	"future_current_contract", "future_continuous_contracts", "future_trading_date",
+	"future_real_time_quote", "future_kline", "future_tick",
+}
+
+// RetryPolicy 控制 Client.call 的自动重试，零值字段使用默认值。
+type RetryPolicy struct {
+	MaxAttempts    int // 包含首次请求，设为 1 关闭重试。
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64  // 退避时间的随机浮动比例，取值 0~1，为 0 时使用默认值。
+	NoJitter       bool     // 关闭随机浮动，退避时间固定，便于测试与确定性重放。
+	Methods        []string // 允许重试的方法，为空时使用只读方法列表。
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if p.InitialBackoff == 0 {
+		p.InitialBackoff = defaultRetryInitialBackoff
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = defaultRetryMaxBackoff
+	}
+	if p.Multiplier == 0 {
+		p.Multiplier = defaultRetryMultiplier
+	}
+	if p.NoJitter {
+		p.Jitter = 0
+	} else if p.Jitter == 0 {
+		p.Jitter = defaultRetryJitter
+	}
+	if len(p.Methods) == 0 {
+		p.Methods = defaultRetryMethods
+	}
+	return p
+}
+
+func (p RetryPolicy) allows(method string) bool {
+	for _, m := range p.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff 返回第 attempt 次重试前的等待时间（attempt 从 1 开始）。
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d += d * p.Jitter * (2*rand.Float64() - 1)
+	}
+	if d < 0 {
+		d = 0
+	}
+	return time.Duration(d)
+}
+
+type idempotencyKey struct{}
+
+// WithIdempotencyKey 标记本次调用可安全重试，place_order 等写方法只有带上该标记才会按 RetryPolicy 重试。
+// 网关没有幂等键：读超时等情况下订单可能已被受理，重试会再次提交。为便于对账，place_order 未设置 UserMark 时
+// SDK 会把 key 写入 user_mark，调用方应在重试后按 UserMark 查询订单并撤销重复单；每次重试也会连同 key 记录日志。
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+func idempotencyKeyFrom(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKey{}).(string)
+	return key
+}
+
+// isRetryable 判断错误是否值得重试：5xx、限流与连接被重置等瞬时错误。
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Kind == KindRateLimit || apiErr.HTTPStatus >= 500
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
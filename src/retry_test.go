@@ -0,0 +1,78 @@
+package tigeropen
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyNoJitter(t *testing.T) {
+	policy := RetryPolicy{NoJitter: true}.withDefaults()
+	want := []time.Duration{200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond}
+	for i, w := range want {
+		if got := policy.backoff(i + 1); got != w {
+			t.Fatalf("backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+	if policy := (RetryPolicy{}).withDefaults(); policy.Jitter != defaultRetryJitter {
+		t.Fatalf("default jitter = %v, want %v", policy.Jitter, defaultRetryJitter)
+	}
+}
+
+func TestIdempotencyKeyWrittenToUserMark(t *testing.T) {
+	var bizContents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		biz, _ := params["biz_content"].(string)
+		bizContents = append(bizContents, biz)
+		if len(bizContents) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"code": 0, "data": map[string]interface{}{"id": 1}})
+	}))
+	defer server.Close()
+
+	tigerKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	var logs bytes.Buffer
+	client := newSignTestClient(t, server.URL, tigerKey, SignVerifyOff, &logs)
+	client.cfg.Retry = RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, NoJitter: true}.withDefaults()
+
+	ctx := WithIdempotencyKey(context.Background(), "order-42")
+	if _, err := client.call(ctx, "place_order", map[string]interface{}{"symbol": "AAPL"}); err != nil {
+		t.Fatalf("place_order: %v", err)
+	}
+	if len(bizContents) != 2 {
+		t.Fatalf("requests = %d, want 2", len(bizContents))
+	}
+	for _, biz := range bizContents {
+		if !strings.Contains(biz, `"user_mark":"order-42"`) {
+			t.Fatalf("biz_content %s missing user_mark", biz)
+		}
+	}
+	if !strings.Contains(logs.String(), "idempotency_key=order-42") {
+		t.Fatalf("retry not logged: %q", logs.String())
+	}
+
+	bizContents = nil
+	if _, err := client.call(ctx, "place_order", map[string]interface{}{"user_mark": "mine"}); err != nil {
+		t.Fatalf("place_order: %v", err)
+	}
+	if !strings.Contains(bizContents[len(bizContents)-1], `"user_mark":"mine"`) {
+		t.Fatalf("caller user_mark overwritten: %s", bizContents[len(bizContents)-1])
+	}
+}
@@ -0,0 +1,187 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// 账户分段：证券（S）与期货（C）。
+const (
+	SegmentSecurities  = "S"
+	SegmentCommodities = "C"
+)
+
+// SegmentTransferRequest 是 transfer_segment_fund 的请求参数。
+type SegmentTransferRequest struct {
+	Account     string
+	SecretKey   string
+	FromSegment string
+	ToSegment   string
+	Currency    string
+	Amount      Decimal
+	Language    string
+}
+
+func (r SegmentTransferRequest) toBiz(cfg Config) map[string]interface{} {
+	account := r.Account
+	if account == "" {
+		account = cfg.Account
+	}
+	secret := r.SecretKey
+	if secret == "" {
+		secret = cfg.SecretKey
+	}
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if account != "" {
+		biz["account"] = account
+	}
+	if secret != "" {
+		biz["secret_key"] = secret
+	}
+	if r.FromSegment != "" {
+		biz["from_segment"] = r.FromSegment
+	}
+	if r.ToSegment != "" {
+		biz["to_segment"] = r.ToSegment
+	}
+	if r.Currency != "" {
+		biz["currency"] = r.Currency
+	}
+	if r.Amount != "" {
+		biz["amount"] = r.Amount.jsonNumber()
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+func (r SegmentTransferRequest) validate() error {
+	if !isSegment(r.FromSegment) || !isSegment(r.ToSegment) {
+		return fmt.Errorf("%w: segments must be %q or %q", ErrInvalidParameter, SegmentSecurities, SegmentCommodities)
+	}
+	if r.FromSegment == r.ToSegment {
+		return fmt.Errorf("%w: from and to segment are both %q", ErrInvalidParameter, r.FromSegment)
+	}
+	if r.Currency == "" {
+		return fmt.Errorf("%w: transfer currency is required", ErrInvalidParameter)
+	}
+	amount, err := ParseDecimal(string(r.Amount))
+	if err != nil {
+		return fmt.Errorf("%w: transfer amount: %v", ErrInvalidParameter, err)
+	}
+	if !amount.IsPositive() {
+		return fmt.Errorf("%w: transfer amount must be positive, got %q", ErrInvalidParameter, r.Amount)
+	}
+	return nil
+}
+
+func isSegment(s string) bool {
+	return s == SegmentSecurities || s == SegmentCommodities
+}
+
+// SegmentFund 是一笔分段资金划转记录。
+type SegmentFund struct {
+	ID          int64           `json:"id"`
+	FromSegment string          `json:"fromSegment"`
+	ToSegment   string          `json:"toSegment"`
+	Currency    string          `json:"currency"`
+	Amount      Decimal         `json:"amount"`
+	Status      string          `json:"status"`
+	StatusDesc  string          `json:"statusDesc,omitempty"`
+	Message     string          `json:"message,omitempty"`
+	SettledAt   int64           `json:"settledAt,omitempty"`
+	UpdatedAt   int64           `json:"updatedAt,omitempty"`
+	CreatedAt   int64           `json:"createdAt,omitempty"`
+	Raw         json.RawMessage `json:"-"`
+}
+
+type SegmentFundResult struct {
+	Response APIResponse
+	Transfer SegmentFund
+}
+
+type SegmentFundHistoryResult struct {
+	Response  APIResponse
+	Transfers []SegmentFund
+}
+
+// TransferSegmentFund 在证券与期货分段之间划转资金。
+func (c *Client) TransferSegmentFund(ctx context.Context, req SegmentTransferRequest) (*SegmentFundResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	return c.callSegmentFund(ctx, "transfer_segment_fund", req.toBiz(c.cfg))
+}
+
+// CancelSegmentFund 撤销尚未完成的分段资金划转。
+func (c *Client) CancelSegmentFund(ctx context.Context, id int64) (*SegmentFundResult, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("%w: cancel_segment_fund requires transfer id", ErrInvalidParameter)
+	}
+	biz := c.accountBiz()
+	biz["id"] = id
+	return c.callSegmentFund(ctx, "cancel_segment_fund", biz)
+}
+
+// GetSegmentFundHistory 查询最近的分段资金划转记录，limit 为 0 时使用网关默认值。
+func (c *Client) GetSegmentFundHistory(ctx context.Context, limit int) (*SegmentFundHistoryResult, error) {
+	biz := c.accountBiz()
+	if limit > 0 {
+		biz["limit"] = limit
+	}
+	resp, err := c.call(ctx, "segment_fund_history", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("segment_fund_history", resp, 0, ""); err != nil {
+		return &SegmentFundHistoryResult{Response: resp}, err
+	}
+	items, err := unwrapItems(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode segment_fund_history data: %w", err)
+	}
+	result := &SegmentFundHistoryResult{Response: resp}
+	for _, raw := range items {
+		var item SegmentFund
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("decode segment fund item: %w", err)
+		}
+		item.Raw = raw
+		result.Transfers = append(result.Transfers, item)
+	}
+	return result, nil
+}
+
+// callSegmentFund 与 callOrder 一致，envelope 与 data 内 code 均会检查。
+func (c *Client) callSegmentFund(ctx context.Context, method string, biz map[string]interface{}) (*SegmentFundResult, error) {
+	resp, err := c.call(ctx, method, biz)
+	if err != nil {
+		return nil, err
+	}
+	var payload SegmentFund
+	var status struct {
+		Code    IntOrString `json:"code"`
+		Message string      `json:"message"`
+	}
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &payload); err != nil {
+			return nil, fmt.Errorf("decode %s response: %w", method, err)
+		}
+		if err := json.Unmarshal(resp.Data, &status); err != nil {
+			return nil, fmt.Errorf("decode %s response: %w", method, err)
+		}
+		payload.Raw = resp.Data
+	}
+	result := &SegmentFundResult{Response: resp, Transfer: payload}
+	if err := checkResponse(method, resp, int(status.Code), status.Message); err != nil {
+		return result, err
+	}
+	return result, nil
+}
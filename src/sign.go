@@ -7,6 +7,7 @@ import (
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
@@ -95,6 +96,51 @@ func signSHA1WithRSA(privateKey *rsa.PrivateKey, content []byte) (string, error)
 	return base64.StdEncoding.EncodeToString(signature), nil
 }
 
+// SignVerifyMode 控制 Client 对响应签名的处理方式。
+// 网关的 sign 只覆盖请求的 timestamp，校验通过只证明响应由网关针对本次请求签发，
+// 不保证 data、code 等响应体字段未被篡改。
+type SignVerifyMode string
+
+const (
+	SignVerifyStrict SignVerifyMode = "strict" // 校验失败返回 *SignatureError。
+	SignVerifyWarn   SignVerifyMode = "warn"   // 校验失败仅记录日志。
+	SignVerifyOff    SignVerifyMode = "off"    // 不校验。
+)
+
+// ErrMissingSignature 表示响应未携带 sign 字段。
+var ErrMissingSignature = errors.New("response signature missing")
+
+// SignatureError 表示响应签名校验失败，即无法确认响应由网关针对本次请求的 timestamp 签发；
+// 签名不覆盖响应体，校验成功也不代表响应体可信。
+type SignatureError struct {
+	Method    string
+	Timestamp string
+	Err       error
+}
+
+func (e *SignatureError) Error() string {
+	return fmt.Sprintf("verify response signature method=%s timestamp=%s: %v", e.Method, e.Timestamp, e.Err)
+}
+
+func (e *SignatureError) Unwrap() error {
+	return e.Err
+}
+
+// buildResponseSignContent 与 python sdk 保持一致：网关对请求的 timestamp 签名并放在响应的 sign 中，
+// 响应体中的任何字段都不参与签名。
+func buildResponseSignContent(timestamp string) []byte {
+	return []byte(timestamp)
+}
+
+func verifySHA1WithRSA(publicKey *rsa.PublicKey, content []byte, sign string) error {
+	signature, err := base64.StdEncoding.DecodeString(sign)
+	if err != nil {
+		return fmt.Errorf("decode signature: %w", err)
+	}
+	hash := sha1.Sum(content)
+	return rsa.VerifyPKCS1v15(publicKey, crypto.SHA1, hash[:], signature)
+}
+
 // marshalDeterministic 生成按键排序、未转义 HTML 的 JSON，行为与 python 的 sort_keys + 紧凑分隔符一致。
 func marshalDeterministic(v interface{}) (string, error) {
 	var b strings.Builder
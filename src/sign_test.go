@@ -0,0 +1,176 @@
+package tigeropen
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// signedGateway 模拟网关：用 tigerKey 对请求的 timestamp 签名，sign 函数可改写签名以构造篡改或缺失的场景。
+func signedGateway(t *testing.T, tigerKey *rsa.PrivateKey, code int, message string, sign func(string) string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		timestamp, _ := params["timestamp"].(string)
+		signature, err := signSHA1WithRSA(tigerKey, buildResponseSignContent(timestamp))
+		if err != nil {
+			t.Errorf("sign timestamp: %v", err)
+			return
+		}
+		resp := map[string]interface{}{
+			"code":    code,
+			"message": message,
+			"data":    map[string]interface{}{},
+		}
+		if s := sign(signature); s != "" {
+			resp["sign"] = s
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func newSignTestClient(t *testing.T, serverURL string, tigerKey *rsa.PrivateKey, mode SignVerifyMode, logs *bytes.Buffer) *Client {
+	t.Helper()
+	userKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generate user key: %v", err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(&tigerKey.PublicKey)
+	if err != nil {
+		t.Fatalf("marshal tiger public key: %v", err)
+	}
+	client, err := NewClient(Config{
+		TigerID:          "test",
+		Account:          "DU0001",
+		PrivateKey:       string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(userKey)})),
+		TigerPublicKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})),
+		ServerURL:        serverURL,
+		SignVerifyMode:   mode,
+		Logger:           log.New(logs, "", 0),
+		Retry:            RetryPolicy{MaxAttempts: 1},
+		DisableRateLimit: true,
+	})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	return client
+}
+
+func TestVerifyResponseSignature(t *testing.T) {
+	tigerKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generate tiger key: %v", err)
+	}
+	valid := func(s string) string { return s }
+	tampered := func(s string) string {
+		raw := []byte(s)
+		if raw[0] == 'A' {
+			raw[0] = 'B'
+		} else {
+			raw[0] = 'A'
+		}
+		return string(raw)
+	}
+	missing := func(string) string { return "" }
+
+	tests := []struct {
+		name    string
+		mode    SignVerifyMode
+		sign    func(string) string
+		wantErr error
+		wantSig bool
+		wantLog bool
+	}{
+		{"strict valid", SignVerifyStrict, valid, nil, false, false},
+		{"strict tampered", SignVerifyStrict, tampered, rsa.ErrVerification, true, false},
+		{"strict missing", SignVerifyStrict, missing, ErrMissingSignature, true, false},
+		{"warn valid", SignVerifyWarn, valid, nil, false, false},
+		{"warn tampered", SignVerifyWarn, tampered, nil, false, true},
+		{"warn missing", SignVerifyWarn, missing, nil, false, true},
+		{"off valid", SignVerifyOff, valid, nil, false, false},
+		{"off tampered", SignVerifyOff, tampered, nil, false, false},
+		{"off missing", SignVerifyOff, missing, nil, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := signedGateway(t, tigerKey, 0, "success", tt.sign)
+			defer server.Close()
+			var logs bytes.Buffer
+			client := newSignTestClient(t, server.URL, tigerKey, tt.mode, &logs)
+
+			_, err := client.call(context.Background(), "assets", nil)
+			var sigErr *SignatureError
+			if got := errors.As(err, &sigErr); got != tt.wantSig {
+				t.Fatalf("SignatureError = %v, want %v (err=%v)", got, tt.wantSig, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got := strings.Contains(logs.String(), "verify response signature"); got != tt.wantLog {
+				t.Fatalf("logged = %v, want %v (logs=%q)", got, tt.wantLog, logs.String())
+			}
+		})
+	}
+}
+
+func TestVerifyResponseUnsignedErrorEnvelope(t *testing.T) {
+	tigerKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generate tiger key: %v", err)
+	}
+	missing := func(string) string { return "" }
+
+	tests := []struct {
+		code    int
+		message string
+		want    error
+	}{
+		{4001, "signature invalid", ErrAuthFailed},
+		{4, "rate limit exceeded", ErrRateLimited},
+		{1010, "bad param", ErrInvalidParameter},
+	}
+	for _, mode := range []SignVerifyMode{SignVerifyStrict, SignVerifyWarn, SignVerifyOff} {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s code=%d", mode, tt.code), func(t *testing.T) {
+				server := signedGateway(t, tigerKey, tt.code, tt.message, missing)
+				defer server.Close()
+				var logs bytes.Buffer
+				client := newSignTestClient(t, server.URL, tigerKey, mode, &logs)
+
+				_, err := client.GetAssets(context.Background(), AssetsRequest{})
+				var sigErr *SignatureError
+				if errors.As(err, &sigErr) {
+					t.Fatalf("unsigned error envelope reported as signature error: %v", err)
+				}
+				var apiErr *APIError
+				if !errors.As(err, &apiErr) || apiErr.Code != tt.code {
+					t.Fatalf("err = %v, want *APIError code=%d", err, tt.code)
+				}
+				if !errors.Is(err, tt.want) {
+					t.Fatalf("errors.Is(%v, %v) = false", err, tt.want)
+				}
+				if logs.Len() != 0 {
+					t.Fatalf("unexpected log output: %q", logs.String())
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package tigeropen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TransactionsRequest 是 order_transactions 的查询条件，OrderID 与 Symbol 至少指定一个。
+type TransactionsRequest struct {
+	Account   string
+	SecretKey string
+	OrderID   *int64
+	Symbol    string
+	SecType   string
+	Expiry    string
+	Strike    *float64
+	PutCall   string
+	StartTime *int64 // 毫秒时间戳。
+	EndTime   *int64 // 毫秒时间戳。
+	Limit     *int
+	PageToken string
+	Language  string
+}
+
+func (r TransactionsRequest) toBiz(cfg Config) map[string]interface{} {
+	account := r.Account
+	if account == "" {
+		account = cfg.Account
+	}
+	secret := r.SecretKey
+	if secret == "" {
+		secret = cfg.SecretKey
+	}
+	lang := r.Language
+	if lang == "" {
+		lang = cfg.Lang
+	}
+
+	biz := map[string]interface{}{}
+	if account != "" {
+		biz["account"] = account
+	}
+	if secret != "" {
+		biz["secret_key"] = secret
+	}
+	if r.OrderID != nil {
+		biz["order_id"] = *r.OrderID
+	}
+	if r.Symbol != "" {
+		biz["symbol"] = r.Symbol
+	}
+	if r.SecType != "" {
+		biz["sec_type"] = r.SecType
+	}
+	if r.Expiry != "" {
+		biz["expiry"] = r.Expiry
+	}
+	if r.Strike != nil {
+		biz["strike"] = *r.Strike
+	}
+	if r.PutCall != "" {
+		biz["right"] = r.PutCall
+	}
+	if r.StartTime != nil {
+		biz["start_date"] = *r.StartTime
+	}
+	if r.EndTime != nil {
+		biz["end_date"] = *r.EndTime
+	}
+	if r.Limit != nil {
+		biz["limit"] = *r.Limit
+	}
+	if r.PageToken != "" {
+		biz["page_token"] = r.PageToken
+	}
+	if lang != "" {
+		biz["lang"] = lang
+	}
+	return biz
+}
+
+// Transaction 是一笔成交明细。
+type Transaction struct {
+	ExecutionID    int64           `json:"id"`
+	Account        string          `json:"accountId,omitempty"`
+	OrderID        int64           `json:"orderId"`
+	Symbol         string          `json:"symbol"`
+	SecType        string          `json:"secType,omitempty"`
+	Market         string          `json:"market,omitempty"`
+	Currency       string          `json:"currency,omitempty"`
+	Action         string          `json:"action,omitempty"`
+	FilledQuantity float64         `json:"filledQuantity"`
+	FilledPrice    float64         `json:"filledPrice"`
+	FilledAmount   float64         `json:"filledAmount,omitempty"`
+	Commission     float64         `json:"commission,omitempty"`
+	Fees           float64         `json:"fees,omitempty"`
+	Exchange       string          `json:"exchange,omitempty"`
+	TradeTime      time.Time       `json:"-"`
+	Raw            json.RawMessage `json:"-"`
+}
+
+func (t *Transaction) UnmarshalJSON(data []byte) error {
+	type alias Transaction
+	aux := struct {
+		*alias
+		TransactionTime int64 `json:"transactionTime"`
+	}{alias: (*alias)(t)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.TradeTime = timeFromMillis(aux.TransactionTime)
+	return nil
+}
+
+type TransactionsData struct {
+	Items         []Transaction
+	NextPageToken string
+}
+
+type TransactionsResult struct {
+	Response     APIResponse
+	Transactions TransactionsData
+}
+
+type transactionsWrapper struct {
+	Items         []json.RawMessage `json:"items"`
+	NextPageToken string            `json:"nextPageToken"`
+}
+
+func (t *TransactionsData) attachRawFrom(wrapper transactionsWrapper) error {
+	t.NextPageToken = wrapper.NextPageToken
+	for _, raw := range wrapper.Items {
+		var item Transaction
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return fmt.Errorf("decode transaction item: %w", err)
+		}
+		item.Raw = raw
+		t.Items = append(t.Items, item)
+	}
+	return nil
+}
+
+// GetOrderTransactions 查询成交明细，按 PageToken 翻页。
+func (c *Client) GetOrderTransactions(ctx context.Context, req TransactionsRequest) (*TransactionsResult, error) {
+	if req.OrderID == nil && req.Symbol == "" {
+		return nil, fmt.Errorf("%w: order_transactions requires OrderID or Symbol", ErrInvalidParameter)
+	}
+	biz := req.toBiz(c.cfg)
+	resp, err := c.call(ctx, "order_transactions", biz)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse("order_transactions", resp, 0, ""); err != nil {
+		return &TransactionsResult{Response: resp}, err
+	}
+	var wrapper transactionsWrapper
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &wrapper); err != nil {
+			// 兼容 data 直接为数组的场景。
+			var items []json.RawMessage
+			if errArray := json.Unmarshal(resp.Data, &items); errArray != nil {
+				return nil, fmt.Errorf("decode order_transactions data: %w", err)
+			}
+			wrapper.Items = items
+		}
+	}
+	var payload TransactionsData
+	if err := payload.attachRawFrom(wrapper); err != nil {
+		return nil, err
+	}
+	return &TransactionsResult{Response: resp, Transactions: payload}, nil
+}
@@ -3,8 +3,12 @@ package tigeropen
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // APIResponse 映射老虎返回的响应包结构。
@@ -13,6 +17,8 @@ type APIResponse struct {
 	Message string          `json:"message"`
 	Data    json.RawMessage `json:"data"`
 	Sign    string          `json:"sign"`
+	// HTTPStatus 是网关响应的 HTTP 状态码，不参与解码。
+	HTTPStatus int `json:"-"`
 }
 
 // Success 表示返回码是否为 0。
@@ -449,6 +455,52 @@ func (o Order) toBiz(cfg Config) map[string]interface{} {
 	return biz
 }
 
+// validateModify 检查改单必需的订单标识，以及价格、数量字段是否与订单类型匹配。
+func (o Order) validateModify() error {
+	if (o.ID == nil || *o.ID == 0) && (o.OrderID == nil || *o.OrderID == 0) {
+		return fmt.Errorf("%w: modify order requires ID or OrderID", ErrInvalidParameter)
+	}
+	if o.Quantity <= 0 {
+		return fmt.Errorf("%w: quantity must be positive, got %v", ErrInvalidParameter, o.Quantity)
+	}
+	if o.LimitPrice != nil && *o.LimitPrice <= 0 {
+		return fmt.Errorf("%w: limit price must be positive, got %v", ErrInvalidParameter, *o.LimitPrice)
+	}
+	if o.AuxPrice != nil && *o.AuxPrice <= 0 {
+		return fmt.Errorf("%w: aux price must be positive, got %v", ErrInvalidParameter, *o.AuxPrice)
+	}
+
+	orderType := strings.ToUpper(o.OrderType)
+	switch orderType {
+	case "MKT":
+		if o.LimitPrice != nil || o.AuxPrice != nil {
+			return fmt.Errorf("%w: MKT order must not set limit or aux price", ErrInvalidParameter)
+		}
+	case "LMT":
+		if o.LimitPrice == nil {
+			return fmt.Errorf("%w: LMT order requires limit price", ErrInvalidParameter)
+		}
+	case "STP":
+		if o.AuxPrice == nil {
+			return fmt.Errorf("%w: STP order requires aux price", ErrInvalidParameter)
+		}
+	case "STP_LMT":
+		if o.LimitPrice == nil || o.AuxPrice == nil {
+			return fmt.Errorf("%w: STP_LMT order requires limit and aux price", ErrInvalidParameter)
+		}
+	case "TRAIL":
+		if o.AuxPrice == nil && o.TrailingPercent == nil {
+			return fmt.Errorf("%w: TRAIL order requires aux price or trailing percent", ErrInvalidParameter)
+		}
+	case "":
+		return fmt.Errorf("%w: order type is required", ErrInvalidParameter)
+	}
+	if o.TrailingPercent != nil && (*o.TrailingPercent <= 0 || *o.TrailingPercent >= 100) {
+		return fmt.Errorf("%w: trailing percent must be in (0, 100), got %v", ErrInvalidParameter, *o.TrailingPercent)
+	}
+	return nil
+}
+
 type AssetsData struct {
 	Items     []AssetItem
 	IsSuccess bool
@@ -525,6 +577,183 @@ func (v *IntOrString) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// FloatOrString allows numeric values encoded as numbers or quoted strings.
+type FloatOrString float64
+
+func (v *FloatOrString) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		*v = 0
+		return nil
+	}
+	if trimmed[0] == '"' {
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*v = 0
+			return nil
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		*v = FloatOrString(f)
+		return nil
+	}
+	var f float64
+	if err := json.Unmarshal(trimmed, &f); err != nil {
+		return err
+	}
+	*v = FloatOrString(f)
+	return nil
+}
+
+// Decimal 以十进制字符串保存金额，避免浮点误差；编码为 JSON 数字，解码兼容数字与带引号的字符串。
+type Decimal string
+
+// decimalPattern 是 JSON 数字的语法（RFC 8259），ParseDecimal 规范化后的结果必须满足。
+var decimalPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// ParseDecimal 校验并规范化十进制字符串，例如 "1000"、"-0.25"、"1e3"。
+// 输入允许前导 "+"、省略整数或小数部分（".5"、"5."）以及多余的前导零（"007"），
+// 输出总是合法的 JSON 数字（"0.5"、"5"、"7"）；千分位、多个小数点等非法形式返回错误。
+func ParseDecimal(s string) (Decimal, error) {
+	raw := strings.TrimSpace(s)
+	if raw == "" {
+		return "", errors.New("empty decimal")
+	}
+	mantissa, exponent := raw, ""
+	if i := strings.IndexAny(raw, "eE"); i >= 0 {
+		mantissa, exponent = raw[:i], raw[i:]
+	}
+	sign := ""
+	switch {
+	case strings.HasPrefix(mantissa, "-"):
+		sign, mantissa = "-", mantissa[1:]
+	case strings.HasPrefix(mantissa, "+"):
+		mantissa = mantissa[1:]
+	}
+	intPart, fracPart, hasDot := strings.Cut(mantissa, ".")
+	if hasDot && fracPart == "" {
+		hasDot = false
+	}
+	if trimmed := strings.TrimLeft(intPart, "0"); trimmed != "" {
+		intPart = trimmed
+	} else if intPart != "" || hasDot {
+		intPart = "0"
+	}
+	normalized := sign + intPart
+	if hasDot {
+		normalized += "." + fracPart
+	}
+	normalized += exponent
+	if !decimalPattern.MatchString(normalized) {
+		return "", fmt.Errorf("invalid decimal %q", s)
+	}
+	return Decimal(normalized), nil
+}
+
+func (d Decimal) String() string {
+	return string(d)
+}
+
+// Float64 返回近似的浮点值，无法解析时返回 0。
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(string(d), 64)
+	return f
+}
+
+// IsPositive 表示金额大于 0，指数部分不影响正负。
+func (d Decimal) IsPositive() bool {
+	s := string(d)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		s = s[:i]
+	}
+	if s == "" || strings.HasPrefix(s, "-") {
+		return false
+	}
+	return strings.Trim(strings.TrimPrefix(s, "+"), "0.") != ""
+}
+
+// jsonNumber 返回规范化后的 json.Number，用于组装 biz_content；无法解析时原样返回，由编码阶段报错。
+func (d Decimal) jsonNumber() json.Number {
+	if parsed, err := ParseDecimal(string(d)); err == nil {
+		return json.Number(parsed)
+	}
+	return json.Number(d)
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d == "" {
+		return []byte("null"), nil
+	}
+	parsed, err := ParseDecimal(string(d))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(parsed), nil
+}
+
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		*d = ""
+		return nil
+	}
+	if trimmed[0] == '"' {
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*d = ""
+			return nil
+		}
+		trimmed = []byte(s)
+	}
+	parsed, err := ParseDecimal(string(trimmed))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// unwrapItems 兼容 data 为数组、{"items": [...]} 或单个对象三种形态。
+func unwrapItems(data json.RawMessage) ([]json.RawMessage, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, err
+		}
+		return items, nil
+	}
+	var wrapper struct {
+		Items *[]json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(trimmed, &wrapper); err != nil {
+		return nil, err
+	}
+	if wrapper.Items != nil {
+		return *wrapper.Items, nil
+	}
+	return []json.RawMessage{json.RawMessage(trimmed)}, nil
+}
+
+// timeFromMillis 将网关的毫秒时间戳转为 time.Time，0 返回零值。
+func timeFromMillis(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
 type OrderIDData struct {
 	OrderID    int64           `json:"orderId,omitempty"`
 	AltOrderID int64           `json:"order_id,omitempty"`
@@ -556,12 +785,183 @@ type OrderResult struct {
 	Order    OrderIDData
 }
 
+// OrderPreview 是 preview_order 返回的保证金与佣金预估，Before 后缀字段为下单前的账户数值。
+type OrderPreview struct {
+	Account              string          `json:"account,omitempty"`
+	IsPass               bool            `json:"isPass"`
+	InitMarginBefore     float64         `json:"initMarginBefore,omitempty"`
+	InitMargin           float64         `json:"initMargin,omitempty"`
+	MaintMarginBefore    float64         `json:"maintMarginBefore,omitempty"`
+	MaintMargin          float64         `json:"maintMargin,omitempty"`
+	EquityWithLoanBefore float64         `json:"equityWithLoanBefore,omitempty"`
+	EquityWithLoan       float64         `json:"equityWithLoan,omitempty"`
+	MarginCurrency       string          `json:"marginCurrency,omitempty"`
+	Commission           float64         `json:"commission,omitempty"`
+	MinCommission        float64         `json:"minCommission,omitempty"`
+	MaxCommission        float64         `json:"maxCommission,omitempty"`
+	CommissionCurrency   string          `json:"commissionCurrency,omitempty"`
+	WarningText          string          `json:"warningText,omitempty"`
+	Message              string          `json:"message,omitempty"` // 拒单原因。
+	Raw                  json.RawMessage `json:"-"`
+}
+
+type PreviewResult struct {
+	Response APIResponse
+	Preview  OrderPreview
+}
+
 type OrdersData struct {
-	Items         []json.RawMessage
+	Items         []OrderRecord
 	NextPageToken string
 	IsSuccess     bool
 }
 
+// OrderStatus 是订单状态，兼容网关返回的多种写法（如 Filled、FILLED、partially_filled）。
+type OrderStatus int
+
+const (
+	OrderStatusUnknown OrderStatus = iota
+	OrderStatusPendingNew
+	OrderStatusSubmitted
+	OrderStatusPartiallyFilled
+	OrderStatusFilled
+	OrderStatusPendingCancel
+	OrderStatusCancelled
+	OrderStatusRejected
+	OrderStatusExpired
+)
+
+var orderStatusNames = map[OrderStatus]string{
+	OrderStatusUnknown:         "Unknown",
+	OrderStatusPendingNew:      "PendingNew",
+	OrderStatusSubmitted:       "Submitted",
+	OrderStatusPartiallyFilled: "PartiallyFilled",
+	OrderStatusFilled:          "Filled",
+	OrderStatusPendingCancel:   "PendingCancel",
+	OrderStatusCancelled:       "Cancelled",
+	OrderStatusRejected:        "Rejected",
+	OrderStatusExpired:         "Expired",
+}
+
+// orderStatusAliases 的 key 为去掉下划线后的小写状态名。
+var orderStatusAliases = map[string]OrderStatus{
+	"initial":         OrderStatusPendingNew,
+	"pendingnew":      OrderStatusPendingNew,
+	"pendingsubmit":   OrderStatusPendingNew,
+	"new":             OrderStatusSubmitted,
+	"submitted":       OrderStatusSubmitted,
+	"held":            OrderStatusSubmitted,
+	"partiallyfilled": OrderStatusPartiallyFilled,
+	"filled":          OrderStatusFilled,
+	"pendingcancel":   OrderStatusPendingCancel,
+	"cancelled":       OrderStatusCancelled,
+	"canceled":        OrderStatusCancelled,
+	"rejected":        OrderStatusRejected,
+	"inactive":        OrderStatusRejected,
+	"invalid":         OrderStatusRejected,
+	"expired":         OrderStatusExpired,
+}
+
+// ParseOrderStatus 将网关状态字符串映射为 OrderStatus，无法识别时返回 OrderStatusUnknown。
+func ParseOrderStatus(s string) OrderStatus {
+	key := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), "_", ""))
+	return orderStatusAliases[key]
+}
+
+func (s OrderStatus) String() string {
+	if name, ok := orderStatusNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("OrderStatus(%d)", int(s))
+}
+
+// IsActive 表示订单仍可能成交或被撤销。
+func (s OrderStatus) IsActive() bool {
+	switch s {
+	case OrderStatusPendingNew, OrderStatusSubmitted, OrderStatusPartiallyFilled, OrderStatusPendingCancel:
+		return true
+	}
+	return false
+}
+
+// IsTerminal 表示订单已进入终态。
+func (s OrderStatus) IsTerminal() bool {
+	switch s {
+	case OrderStatusFilled, OrderStatusCancelled, OrderStatusRejected, OrderStatusExpired:
+		return true
+	}
+	return false
+}
+
+func (s *OrderStatus) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		*s = OrderStatusUnknown
+		return nil
+	}
+	if trimmed[0] != '"' {
+		// 数字状态码未收录，保留为 Unknown，可从 Raw 自行解析。
+		*s = OrderStatusUnknown
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(trimmed, &str); err != nil {
+		return err
+	}
+	*s = ParseOrderStatus(str)
+	return nil
+}
+
+func (s OrderStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// OrderLeg 是组合订单中的单腿。
+type OrderLeg struct {
+	Symbol     string        `json:"symbol"`
+	SecType    string        `json:"secType,omitempty"`
+	Expiry     string        `json:"expiry,omitempty"`
+	Strike     FloatOrString `json:"strike,omitempty"`
+	PutCall    string        `json:"right,omitempty"`
+	Action     string        `json:"action,omitempty"`
+	Ratio      int           `json:"ratio,omitempty"`
+	Multiplier FloatOrString `json:"multiplier,omitempty"`
+}
+
+// OrderRecord 是订单查询返回的单条订单。
+type OrderRecord struct {
+	ID             int64           `json:"id"`
+	OrderID        int64           `json:"orderId,omitempty"`
+	Account        string          `json:"account,omitempty"`
+	Symbol         string          `json:"symbol"`
+	SecType        string          `json:"secType,omitempty"`
+	Market         string          `json:"market,omitempty"`
+	Currency       string          `json:"currency,omitempty"`
+	Expiry         string          `json:"expiry,omitempty"`
+	PutCall        string          `json:"right,omitempty"`
+	Action         string          `json:"action"`
+	OrderType      string          `json:"orderType"`
+	Status         OrderStatus     `json:"status"`
+	TotalQuantity  float64         `json:"totalQuantity,omitempty"`
+	FilledQuantity float64         `json:"filledQuantity,omitempty"`
+	AvgFillPrice   float64         `json:"avgFillPrice,omitempty"`
+	LimitPrice     float64         `json:"limitPrice,omitempty"`
+	AuxPrice       float64         `json:"auxPrice,omitempty"`
+	TimeInForce    string          `json:"timeInForce,omitempty"`
+	OutsideRTH     bool            `json:"outsideRth,omitempty"`
+	Commission     float64         `json:"commission,omitempty"`
+	RealizedPnL    float64         `json:"realizedPnl,omitempty"`
+	Remark         string          `json:"remark,omitempty"`
+	UserMark       string          `json:"userMark,omitempty"`
+	OpenTime       int64           `json:"openTime,omitempty"`
+	UpdateTime     int64           `json:"updateTime,omitempty"`
+	ComboType      string          `json:"comboType,omitempty"`
+	Legs           []OrderLeg      `json:"legs,omitempty"`
+	AlgoStrategy   string          `json:"algoStrategy,omitempty"`
+	AlgoParams     json.RawMessage `json:"algoParams,omitempty"`
+	Raw            json.RawMessage `json:"-"`
+}
+
 type OrdersResult struct {
 	Response APIResponse
 	Orders   OrdersData
@@ -593,6 +993,20 @@ func (p *PositionsData) attachRawFrom(wrapper positionsWrapper) error {
 	return nil
 }
 
+func (o *OrdersData) attachRawFrom(wrapper ordersWrapper) error {
+	o.NextPageToken = wrapper.NextPageToken
+	o.IsSuccess = wrapper.IsSuccess
+	for _, raw := range wrapper.Items {
+		var item OrderRecord
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return fmt.Errorf("decode order item: %w", err)
+		}
+		item.Raw = raw
+		o.Items = append(o.Items, item)
+	}
+	return nil
+}
+
 type assetsWrapper struct {
 	Items     []json.RawMessage `json:"items"`
 	IsSuccess bool              `json:"is_success"`
@@ -0,0 +1,95 @@
+package tigeropen
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	accepted := []struct {
+		in, want string
+	}{
+		{"1000", "1000"},
+		{"-0.25", "-0.25"},
+		{"+12.5", "12.5"},
+		{" 7 ", "7"},
+		{".5", "0.5"},
+		{"-.5", "-0.5"},
+		{"5.", "5"},
+		{"007", "7"},
+		{"00", "0"},
+		{"-007.50", "-7.50"},
+		{"1e3", "1e3"},
+		{"1.0E-4", "1.0E-4"},
+		{"2E+2", "2E+2"},
+	}
+	for _, tt := range accepted {
+		got, err := ParseDecimal(tt.in)
+		if err != nil {
+			t.Errorf("ParseDecimal(%q) error: %v", tt.in, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("ParseDecimal(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	rejected := []string{"", " ", ".", "-", "+", "1,000", "1.2.3", "--1", "1-", "e3", "1e", "1e+", ".e1", "abc", "0x10", "NaN", "Inf", "1 000"}
+	for _, in := range rejected {
+		if got, err := ParseDecimal(in); err == nil {
+			t.Errorf("ParseDecimal(%q) = %q, want error", in, got)
+		}
+	}
+}
+
+func TestDecimalIsPositive(t *testing.T) {
+	tests := map[Decimal]bool{
+		"1": true, "0.01": true, "1e-3": true, "2E+2": true,
+		"0": false, "0.00": false, "0e5": false, "-1": false, "-1e3": false, "": false,
+	}
+	for d, want := range tests {
+		if got := d.IsPositive(); got != want {
+			t.Errorf("Decimal(%q).IsPositive() = %v, want %v", d, got, want)
+		}
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{`1e3`, "1e3"},
+		{`".5"`, "0.5"},
+		{`"1.0E-4"`, "1.0E-4"},
+		{`null`, ""},
+	} {
+		var d Decimal
+		if err := json.Unmarshal([]byte(tt.in), &d); err != nil {
+			t.Errorf("Unmarshal(%s) error: %v", tt.in, err)
+			continue
+		}
+		if string(d) != tt.want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", tt.in, d, tt.want)
+		}
+	}
+	for _, in := range []string{`"1,000"`, `"007x"`, `"."`} {
+		var d Decimal
+		if err := json.Unmarshal([]byte(in), &d); err == nil {
+			t.Errorf("Unmarshal(%s) = %q, want error", in, d)
+		}
+	}
+
+	out, err := json.Marshal(map[string]interface{}{"amount": Decimal("5."), "fee": Decimal("")})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(out) != `{"amount":5,"fee":null}` {
+		t.Fatalf("Marshal = %s", out)
+	}
+	if _, err := json.Marshal(Decimal("1,000")); err == nil {
+		t.Fatal("Marshal of invalid decimal should fail")
+	}
+	if got := Decimal(".5").jsonNumber(); got != "0.5" {
+		t.Fatalf("jsonNumber = %q, want 0.5", got)
+	}
+}